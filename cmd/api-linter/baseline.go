@@ -0,0 +1,136 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/googleapis/api-linter/v2/lint"
+	"gopkg.in/yaml.v3"
+)
+
+// baselineEntry is the fingerprint of a known problem.
+//
+// It deliberately does not contain line numbers, so that a baseline keeps
+// matching its problems after unrelated edits to the same file.
+type baselineEntry struct {
+	RuleID      lint.RuleName `json:"rule_id" yaml:"rule_id"`
+	FilePath    string        `json:"file_path" yaml:"file_path"`
+	Descriptor  string        `json:"descriptor" yaml:"descriptor"`
+	MessageHash string        `json:"message_hash" yaml:"message_hash"`
+}
+
+func newBaselineEntry(filePath string, p lint.Problem) baselineEntry {
+	var descriptor string
+	if p.Descriptor != nil {
+		descriptor = string(p.Descriptor.FullName())
+	}
+	sum := sha256.Sum256([]byte(p.Message))
+	return baselineEntry{
+		RuleID:      p.RuleID,
+		FilePath:    filePath,
+		Descriptor:  descriptor,
+		MessageHash: hex.EncodeToString(sum[:8]),
+	}
+}
+
+// createBaseline returns the fingerprints of every problem in the results,
+// in a stable order. Compile errors are left out, since they must be fixed
+// before the file can be linted at all.
+func createBaseline(results []lint.Response) []baselineEntry {
+	entries := []baselineEntry{}
+	for _, r := range results {
+		for _, p := range r.Problems {
+			if p.RuleID == compileErrorRuleID {
+				continue
+			}
+			entries = append(entries, newBaselineEntry(r.FilePath, p))
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.FilePath != b.FilePath {
+			return a.FilePath < b.FilePath
+		}
+		if a.RuleID != b.RuleID {
+			return a.RuleID < b.RuleID
+		}
+		if a.Descriptor != b.Descriptor {
+			return a.Descriptor < b.Descriptor
+		}
+		return a.MessageHash < b.MessageHash
+	})
+	return entries
+}
+
+func writeBaseline(path string, entries []baselineEntry) error {
+	b, err := yaml.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0o644)
+}
+
+func readBaseline(path string) ([]baselineEntry, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading baseline: %w", err)
+	}
+	var entries []baselineEntry
+	if err := yaml.Unmarshal(b, &entries); err != nil {
+		return nil, fmt.Errorf("reading baseline %q: %w", path, err)
+	}
+	return entries, nil
+}
+
+// applyBaseline removes the problems recorded in the baseline from the
+// results. Each entry suppresses at most one problem, so that new
+// occurrences of a known problem are still reported. Compile errors are
+// never suppressed.
+//
+// It also returns the stale entries, which did not match any problem.
+func applyBaseline(results []lint.Response, entries []baselineEntry) ([]lint.Response, []baselineEntry) {
+	remaining := make(map[baselineEntry]int, len(entries))
+	for _, e := range entries {
+		remaining[e]++
+	}
+
+	filtered := make([]lint.Response, 0, len(results))
+	for _, r := range results {
+		problems := []lint.Problem{}
+		for _, p := range r.Problems {
+			e := newBaselineEntry(r.FilePath, p)
+			if p.RuleID != compileErrorRuleID && remaining[e] > 0 {
+				remaining[e]--
+				continue
+			}
+			problems = append(problems, p)
+		}
+		filtered = append(filtered, lint.Response{FilePath: r.FilePath, Problems: problems})
+	}
+
+	stale := []baselineEntry{}
+	for _, e := range entries {
+		if remaining[e] > 0 {
+			remaining[e]--
+			stale = append(stale, e)
+		}
+	}
+	return filtered, stale
+}
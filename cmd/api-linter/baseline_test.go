@@ -0,0 +1,108 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/api-linter/v2/lint"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestApplyBaseline(t *testing.T) {
+	fd, err := protodesc.NewFile(&dpb.FileDescriptorProto{
+		Name:        proto.String("example.proto"),
+		Package:     proto.String("example"),
+		MessageType: []*dpb.DescriptorProto{{Name: proto.String("Foo")}, {Name: proto.String("Bar")}},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	foo, bar := fd.Messages().Get(0), fd.Messages().Get(1)
+
+	known := []lint.Response{{
+		FilePath: "example.proto",
+		Problems: []lint.Problem{
+			{RuleID: "core::0001::a", Message: "a", Descriptor: foo},
+			{RuleID: "core::0001::b", Message: "b", Descriptor: bar},
+		},
+	}}
+	baseline := createBaseline(known)
+
+	// Write and read the baseline back.
+	path := filepath.Join(t.TempDir(), "baseline.yaml")
+	if err := writeBaseline(path, baseline); err != nil {
+		t.Fatal(err)
+	}
+	baseline, err = readBaseline(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Move a known problem, fix the other one, and add new problems.
+	current := []lint.Response{{
+		FilePath: "example.proto",
+		Problems: []lint.Problem{
+			{RuleID: "core::0001::a", Message: "a", Descriptor: foo, Location: &dpb.SourceCodeInfo_Location{Span: []int32{10, 0, 5}}},
+			{RuleID: "core::0001::a", Message: "a", Descriptor: foo},
+			{RuleID: "core::0001::a", Message: "a changed", Descriptor: foo},
+			{RuleID: "core::0001::a", Message: "a", Descriptor: bar},
+		},
+	}}
+	filtered, stale := applyBaseline(current, baseline)
+
+	var got []string
+	for _, p := range filtered[0].Problems {
+		got = append(got, string(p.Descriptor.Name())+": "+p.Message)
+	}
+	if diff := cmp.Diff([]string{"Foo: a", "Foo: a changed", "Bar: a"}, got); diff != "" {
+		t.Errorf("applyBaseline() problems mismatch (-want +got):\n%s", diff)
+	}
+	if len(stale) != 1 || stale[0].RuleID != "core::0001::b" || stale[0].Descriptor != "example.Bar" {
+		t.Errorf("applyBaseline() stale entries = %v, want the core::0001::b entry", stale)
+	}
+}
+
+func TestBaselineCompileErrors(t *testing.T) {
+	fd, err := protodesc.NewFile(&dpb.FileDescriptorProto{Name: proto.String("broken.proto")}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := []lint.Response{{
+		FilePath: "broken.proto",
+		Problems: []lint.Problem{
+			{RuleID: compileErrorRuleID, Message: "undefined: Missing", Descriptor: fd},
+			{RuleID: "core::0001::a", Message: "a", Descriptor: fd},
+		},
+	}}
+	baseline := createBaseline(results)
+	if len(baseline) != 1 || baseline[0].RuleID != "core::0001::a" {
+		t.Errorf("createBaseline() = %v, want only the core::0001::a entry", baseline)
+	}
+
+	// A compile error is reported even if an older baseline records it.
+	baseline = append(baseline, newBaselineEntry("broken.proto", results[0].Problems[0]))
+	filtered, stale := applyBaseline(results, baseline)
+	if len(filtered[0].Problems) != 1 || filtered[0].Problems[0].RuleID != compileErrorRuleID {
+		t.Errorf("applyBaseline() problems = %v, want the compile error", filtered[0].Problems)
+	}
+	if len(stale) != 1 || stale[0].RuleID != compileErrorRuleID {
+		t.Errorf("applyBaseline() stale entries = %v, want the compile-error entry", stale)
+	}
+}
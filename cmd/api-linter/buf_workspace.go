@@ -0,0 +1,332 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/googleapis/api-linter/v2/lint"
+	"gopkg.in/yaml.v3"
+)
+
+// bufWorkFile is a `buf.work.yaml` file, which lists the directories of the
+// modules of a v1 workspace.
+type bufWorkFile struct {
+	Version     string   `yaml:"version"`
+	Directories []string `yaml:"directories"`
+}
+
+// bufYAMLFile is a `buf.yaml` file: a v1 module, or a v2 workspace.
+type bufYAMLFile struct {
+	Version string `yaml:"version"`
+
+	// Build holds the excludes of a v1 module, relative to the module.
+	Build struct {
+		Excludes []string `yaml:"excludes"`
+	} `yaml:"build"`
+
+	// Modules are the modules of a v2 workspace, which has a single module at
+	// its root if none is listed.
+	Modules []bufModuleConfig `yaml:"modules"`
+
+	Lint bufLintConfig `yaml:"lint"`
+}
+
+// bufModuleConfig is a module of a v2 workspace. Its paths are relative to
+// the workspace.
+type bufModuleConfig struct {
+	Path     string         `yaml:"path"`
+	Excludes []string       `yaml:"excludes"`
+	Lint     *bufLintConfig `yaml:"lint"`
+}
+
+type bufLintConfig struct {
+	// IgnoreOnly maps rules to the files and directories that they ignore.
+	IgnoreOnly map[string][]string `yaml:"ignore_only"`
+}
+
+// bufLockFile is a `buf.lock` file, which pins the dependencies of a module
+// (v1) or a workspace (v2).
+type bufLockFile struct {
+	Deps []struct {
+		// Name is the full name of a v2 dependency, such as
+		// `buf.build/googleapis/googleapis`.
+		Name string `yaml:"name"`
+		// Remote, Owner and Repository name a v1 dependency.
+		Remote     string `yaml:"remote"`
+		Owner      string `yaml:"owner"`
+		Repository string `yaml:"repository"`
+		Commit     string `yaml:"commit"`
+		Digest     string `yaml:"digest"`
+	} `yaml:"deps"`
+}
+
+// bufWorkspace is what the linter uses of a buf workspace.
+type bufWorkspace struct {
+	// importPaths are the roots of the modules, followed by the directories
+	// of the dependencies found in the buf cache.
+	importPaths []string
+	// configs map the excludes and the `lint.ignore_only` entries of the
+	// modules, with paths relative to the roots of the modules.
+	configs lint.Configs
+	// skippedRules are the rules of buf itself in the `lint.ignore_only`
+	// entries, which have no equivalent in the linter, sorted.
+	skippedRules []string
+}
+
+// useBufWorkspace adds the module roots and the dependencies of the buf
+// workspace to the import paths, and returns the configs with those of the
+// workspace appended.
+func (c *cli) useBufWorkspace(configs lint.Configs) (lint.Configs, error) {
+	if c.BufWorkspace == "" {
+		return configs, nil
+	}
+	cacheDir := c.BufCacheDir
+	if cacheDir == "" {
+		cacheDir = defaultBufCacheDir()
+	}
+	ws, err := readBufWorkspace(c.BufWorkspace, cacheDir)
+	if err != nil {
+		return nil, err
+	}
+	if len(ws.skippedRules) > 0 {
+		fmt.Fprintf(os.Stderr, "note: skipping the lint.ignore_only entries of buf rules: %s\n", strings.Join(ws.skippedRules, ", "))
+	}
+	c.ProtoImportPaths = append(append([]string(nil), c.ProtoImportPaths...), ws.importPaths...)
+	return append(configs, ws.configs...), nil
+}
+
+// readBufWorkspace reads the `buf.work.yaml` or `buf.yaml` file of a
+// directory. The dependencies are only looked up in the buf cache, and
+// missing ones are left for the compiler to report.
+func readBufWorkspace(dir, cacheDir string) (*bufWorkspace, error) {
+	ws := &bufWorkspace{}
+	var lockPaths []string
+	addModule := func(root string, excludes []string, lint bufLintConfig) {
+		ws.importPaths = append(ws.importPaths, root)
+		if len(excludes) > 0 {
+			ws.configs = append(ws.configs, bufExcludesConfig(excludes))
+		}
+		configs, skipped := bufIgnoreOnlyConfigs(lint.IgnoreOnly)
+		ws.configs = append(ws.configs, configs...)
+		for _, rule := range skipped {
+			if !slices.Contains(ws.skippedRules, rule) {
+				ws.skippedRules = append(ws.skippedRules, rule)
+			}
+		}
+	}
+
+	work, workErr := readBufFile[bufWorkFile](filepath.Join(dir, "buf.work.yaml"))
+	buf, bufErr := readBufFile[bufYAMLFile](filepath.Join(dir, "buf.yaml"))
+	switch {
+	case workErr != nil:
+		return nil, workErr
+	case bufErr != nil:
+		return nil, bufErr
+	case work != nil:
+		// A v1 workspace: each directory is a v1 module, whose paths are
+		// relative to the module.
+		for _, d := range work.Directories {
+			root := filepath.Join(dir, filepath.FromSlash(d))
+			module, err := readBufFile[bufYAMLFile](filepath.Join(root, "buf.yaml"))
+			if err != nil {
+				return nil, err
+			}
+			if module == nil {
+				module = &bufYAMLFile{}
+			}
+			addModule(root, module.Build.Excludes, module.Lint)
+			lockPaths = append(lockPaths, filepath.Join(root, "buf.lock"))
+		}
+	case buf == nil:
+		return nil, fmt.Errorf("no buf.work.yaml or buf.yaml file in %s", dir)
+	case buf.Version == "v1":
+		addModule(dir, buf.Build.Excludes, buf.Lint)
+		lockPaths = append(lockPaths, filepath.Join(dir, "buf.lock"))
+	case buf.Version == "v2":
+		// A v2 workspace: the paths are relative to the workspace, and are
+		// made relative to the module they are in.
+		modules := buf.Modules
+		if len(modules) == 0 {
+			modules = []bufModuleConfig{{Path: "."}}
+		}
+		for _, m := range modules {
+			lint := buf.Lint
+			if m.Lint != nil {
+				lint = *m.Lint
+			}
+			ignoreOnly := map[string][]string{}
+			for rule, paths := range lint.IgnoreOnly {
+				ignoreOnly[rule] = modulePaths(m.Path, paths)
+			}
+			addModule(filepath.Join(dir, filepath.FromSlash(m.Path)), modulePaths(m.Path, m.Excludes), bufLintConfig{IgnoreOnly: ignoreOnly})
+		}
+		lockPaths = append(lockPaths, filepath.Join(dir, "buf.lock"))
+	default:
+		return nil, fmt.Errorf("%s: unsupported buf.yaml version %q, expected v1 or v2", filepath.Join(dir, "buf.yaml"), buf.Version)
+	}
+
+	sort.Strings(ws.skippedRules)
+
+	seen := map[string]bool{}
+	for _, lockPath := range lockPaths {
+		deps, err := bufCachedDeps(lockPath, cacheDir)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range deps {
+			if !seen[d] {
+				seen[d] = true
+				ws.importPaths = append(ws.importPaths, d)
+			}
+		}
+	}
+	return ws, nil
+}
+
+// readBufFile reads a buf file, or returns nil if it does not exist.
+func readBufFile[T any](path string) (*T, error) {
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	v := new(T)
+	if err := yaml.Unmarshal(b, v); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return v, nil
+}
+
+// modulePaths returns the paths, relative to a v2 workspace, that are in a
+// module, relative to the module.
+func modulePaths(module string, paths []string) []string {
+	module = path.Clean(filepath.ToSlash(module))
+	var rel []string
+	for _, p := range paths {
+		p = path.Clean(filepath.ToSlash(p))
+		switch {
+		case module == ".":
+			rel = append(rel, p)
+		case p == module:
+			rel = append(rel, ".")
+		case strings.HasPrefix(p, module+"/"):
+			rel = append(rel, strings.TrimPrefix(p, module+"/"))
+		}
+	}
+	return rel
+}
+
+// bufPathPatterns returns the patterns that match the files and directories
+// of buf paths.
+func bufPathPatterns(paths []string) []string {
+	var patterns []string
+	for _, p := range paths {
+		p = path.Clean(filepath.ToSlash(p))
+		if p == "." {
+			patterns = append(patterns, "**")
+			continue
+		}
+		patterns = append(patterns, p, p+"/**")
+	}
+	return patterns
+}
+
+// bufExcludesConfig returns a config that ignores the excluded directories
+// of a module.
+func bufExcludesConfig(excludes []string) lint.Config {
+	return lint.Config{IgnoredPaths: bufPathPatterns(excludes)}
+}
+
+// bufIgnoreOnlyConfigs returns the configs that disable the rules of the
+// linter on the paths that `lint.ignore_only` maps them to, and the rules of
+// buf itself, which are left out, sorted.
+func bufIgnoreOnlyConfigs(ignoreOnly map[string][]string) (lint.Configs, []string) {
+	var rules, skipped []string
+	for rule := range ignoreOnly {
+		if strings.Contains(rule, "::") {
+			rules = append(rules, rule)
+		} else {
+			skipped = append(skipped, rule)
+		}
+	}
+	sort.Strings(rules)
+	sort.Strings(skipped)
+	var configs lint.Configs
+	for _, rule := range rules {
+		if patterns := bufPathPatterns(ignoreOnly[rule]); len(patterns) > 0 {
+			configs = append(configs, lint.Config{
+				IncludedPaths: patterns,
+				DisabledRules: []string{rule},
+			})
+		}
+	}
+	return configs, skipped
+}
+
+// bufCachedDeps returns the directories of the dependencies pinned by a
+// `buf.lock` file that are in the buf cache.
+func bufCachedDeps(lockPath, cacheDir string) ([]string, error) {
+	lock, err := readBufFile[bufLockFile](lockPath)
+	if err != nil || lock == nil || cacheDir == "" {
+		return nil, err
+	}
+	var dirs []string
+	for _, dep := range lock.Deps {
+		name := dep.Name
+		if name == "" {
+			name = path.Join(dep.Remote, dep.Owner, dep.Repository)
+		}
+		name = filepath.FromSlash(name)
+		digestType, digest, _ := strings.Cut(dep.Digest, ":")
+		// The modules are stored by commit in the v1 cache, and by digest in
+		// the files directory of the v3 cache.
+		candidates := []string{
+			filepath.Join(cacheDir, "v1", "module", "data", name, dep.Commit),
+			filepath.Join(cacheDir, "v3", "modules", digestType, name, digest, "files"),
+			filepath.Join(cacheDir, "v3", "modules", digestType, name, dep.Commit, "files"),
+		}
+		for _, dir := range candidates {
+			if info, err := os.Stat(dir); err == nil && info.IsDir() {
+				dirs = append(dirs, dir)
+				break
+			}
+		}
+	}
+	return dirs, nil
+}
+
+// defaultBufCacheDir returns the cache directory of buf: $BUF_CACHE_DIR, or
+// the buf directory of $XDG_CACHE_HOME or of ~/.cache.
+func defaultBufCacheDir() string {
+	if dir := os.Getenv("BUF_CACHE_DIR"); dir != "" {
+		return dir
+	}
+	if dir := os.Getenv("XDG_CACHE_HOME"); dir != "" {
+		return filepath.Join(dir, "buf")
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		return filepath.Join(home, ".cache", "buf")
+	}
+	return ""
+}
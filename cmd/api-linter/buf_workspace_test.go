@@ -0,0 +1,156 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/api-linter/v2/lint"
+)
+
+func TestReadBufWorkspace_V1(t *testing.T) {
+	root := t.TempDir()
+	cache := filepath.Join(root, "cache")
+	writeFiles(t, root, map[string]string{
+		"buf.work.yaml": "version: v1\ndirectories: [proto, vendor]\n",
+		"proto/buf.yaml": `version: v1
+build:
+  excludes: [legacy]
+lint:
+  ignore_only:
+    FIELD_LOWER_SNAKE_CASE: [api/a.proto]
+    core::0140::lower-snake: [api/a.proto, api/v1]
+`,
+		"proto/buf.lock": `version: v1
+deps:
+  - remote: buf.build
+    owner: googleapis
+    repository: googleapis
+    commit: abc123
+    digest: shake256:def456
+  - remote: buf.build
+    owner: acme
+    repository: missing
+    commit: 789
+`,
+		"cache/v1/module/data/buf.build/googleapis/googleapis/abc123/google/api/resource.proto": "",
+	})
+
+	got, err := readBufWorkspace(root, cache)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantImports := []string{
+		filepath.Join(root, "proto"),
+		filepath.Join(root, "vendor"),
+		filepath.Join(cache, "v1", "module", "data", "buf.build", "googleapis", "googleapis", "abc123"),
+	}
+	if diff := cmp.Diff(wantImports, got.importPaths); diff != "" {
+		t.Errorf("importPaths mismatch (-want +got):\n%s", diff)
+	}
+	wantConfigs := lint.Configs{
+		{IgnoredPaths: []string{"legacy", "legacy/**"}},
+		{
+			IncludedPaths: []string{"api/a.proto", "api/a.proto/**", "api/v1", "api/v1/**"},
+			DisabledRules: []string{"core::0140::lower-snake"},
+		},
+	}
+	if diff := cmp.Diff(wantConfigs, got.configs); diff != "" {
+		t.Errorf("configs mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"FIELD_LOWER_SNAKE_CASE"}, got.skippedRules); diff != "" {
+		t.Errorf("skippedRules mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestReadBufWorkspace_V2(t *testing.T) {
+	root := t.TempDir()
+	cache := filepath.Join(root, "cache")
+	writeFiles(t, root, map[string]string{
+		"buf.yaml": `version: v2
+modules:
+  - path: proto
+    excludes: [proto/legacy, other/legacy]
+  - path: extra
+    lint:
+      ignore_only:
+        core::0131: [extra/b.proto]
+lint:
+  ignore_only:
+    core::0140: [proto/api/a.proto, extra/api]
+`,
+		"buf.lock": `version: v2
+deps:
+  - name: buf.build/googleapis/googleapis
+    commit: abc123
+    digest: b5:def456
+`,
+		"cache/v3/modules/b5/buf.build/googleapis/googleapis/def456/files/google/api/resource.proto": "",
+	})
+
+	got, err := readBufWorkspace(root, cache)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantImports := []string{
+		filepath.Join(root, "proto"),
+		filepath.Join(root, "extra"),
+		filepath.Join(cache, "v3", "modules", "b5", "buf.build", "googleapis", "googleapis", "def456", "files"),
+	}
+	if diff := cmp.Diff(wantImports, got.importPaths); diff != "" {
+		t.Errorf("importPaths mismatch (-want +got):\n%s", diff)
+	}
+	// The top-level lint config only applies to the modules without their
+	// own, and the paths are made relative to the modules.
+	wantConfigs := lint.Configs{
+		{IgnoredPaths: []string{"legacy", "legacy/**"}},
+		{
+			IncludedPaths: []string{"api/a.proto", "api/a.proto/**"},
+			DisabledRules: []string{"core::0140"},
+		},
+		{
+			IncludedPaths: []string{"b.proto", "b.proto/**"},
+			DisabledRules: []string{"core::0131"},
+		},
+	}
+	if diff := cmp.Diff(wantConfigs, got.configs); diff != "" {
+		t.Errorf("configs mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestReadBufWorkspace_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  string
+	}{
+		{"NoFile", map[string]string{"a.proto": ""}, "no buf.work.yaml or buf.yaml file"},
+		{"UnsupportedVersion", map[string]string{"buf.yaml": "version: v1beta1\n"}, `unsupported buf.yaml version "v1beta1"`},
+		{"InvalidYAML", map[string]string{"buf.work.yaml": "directories: {\n"}, "buf.work.yaml"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := t.TempDir()
+			writeFiles(t, root, test.files)
+			_, err := readBufWorkspace(root, "")
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("readBufWorkspace() got error %v, want it to contain %q", err, test.want)
+			}
+		})
+	}
+}
@@ -15,7 +15,6 @@
 package main
 
 import (
-	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -25,8 +24,6 @@ import (
 	"strings"
 
 	"github.com/bufbuild/protocompile"
-	"github.com/bufbuild/protocompile/linker"
-	"github.com/bufbuild/protocompile/reporter"
 	"github.com/googleapis/api-linter/v2/internal"
 	"github.com/googleapis/api-linter/v2/lint"
 	"github.com/spf13/pflag"
@@ -43,6 +40,7 @@ type cli struct {
 	FormatType                string
 	OutputPath                string
 	ExitStatusOnLintFailure   bool
+	ExitStatusSeverity        string
 	VersionFlag               bool
 	ProtoImportPaths          []string
 	ProtoFiles                []string
@@ -53,6 +51,19 @@ type cli struct {
 	ListRulesFlag             bool
 	DebugFlag                 bool
 	IgnoreCommentDisablesFlag bool
+	BaselinePath              string
+	WriteBaselinePath         string
+	FixFlag                   bool
+	FixDryRunFlag             bool
+	Concurrency               int
+	GroupByDescriptorFlag     bool
+	DiffBase                  string
+	DiffFilePath              string
+	Plugins                   []string
+	ReportUnusedDisablesFlag  bool
+	BufWorkspace              string
+	BufCacheDir               string
+	WatchFlag                 bool
 }
 
 // ExitForLintFailure indicates that a problem was found during linting.
@@ -66,6 +77,7 @@ func newCli(args []string) *cli {
 	var fmtFlag string
 	var outFlag string
 	var setExitStatusOnLintFailure bool
+	var exitStatusSeverityFlag string
 	var versionFlag bool
 	var protoImportFlag []string
 	var protoDescFlag []string
@@ -75,22 +87,49 @@ func newCli(args []string) *cli {
 	var listRulesFlag bool
 	var debugFlag bool
 	var ignoreCommentDisablesFlag bool
+	var baselineFlag string
+	var writeBaselineFlag string
+	var fixFlag bool
+	var fixDryRunFlag bool
+	var concurrencyFlag int
+	var groupByDescriptorFlag bool
+	var diffBaseFlag string
+	var diffFileFlag string
+	var pluginFlag []string
+	var reportUnusedDisablesFlag bool
+	var watchFlag bool
+	var bufWorkspaceFlag string
+	var bufCacheDirFlag string
 
 	// Register flag variables.
 	fs := pflag.NewFlagSet("api-linter", pflag.ExitOnError)
-	fs.StringVar(&cfgFlag, "config", "", "The linter config file.")
-	fs.StringVar(&fmtFlag, "output-format", "", "The format of the linting results.\nSupported formats include \"yaml\", \"json\",\"github\" and \"summary\" table.\nYAML is the default.")
+	fs.StringVar(&cfgFlag, "config", "", "The linter config file. If not set, the config files next to\nthe proto files and in their parent directories are used.")
+	fs.StringVar(&fmtFlag, "output-format", "", "The format of the linting results.\nSupported formats include \"yaml\", \"json\", \"github\", \"sarif\", \"text\" and \"summary\" table.\nYAML is the default.")
 	fs.StringVarP(&outFlag, "output-path", "o", "", "The output file path.\nIf not given, the linting results will be printed out to STDOUT.")
 	fs.BoolVar(&setExitStatusOnLintFailure, "set-exit-status", false, "Return exit status 1 when lint errors are found.")
+	fs.StringVar(&exitStatusSeverityFlag, "exit-status-severity", "", "The minimum severity of the problems that make --set-exit-status\nreturn exit status 1. Supported severities include \"error\",\n\"warning\" and \"info\". By default, any problem is a failure.")
 	fs.BoolVar(&versionFlag, "version", false, "Print version and exit.")
 	fs.StringArrayVarP(&protoImportFlag, "proto-path", "I", nil, "The folder for searching proto imports.\nMay be specified multiple times; directories will be searched in order.\nThe current working directory is always used.")
 	fs.StringArrayVar(&protoDescFlag, "descriptor-set-in", nil, "The file containing a FileDescriptorSet for searching proto imports.\nMay be specified multiple times.\nAlso used as the source of proto files to lint when --skip-compilation is enabled.")
 	fs.BoolVar(&skipCompilationFlag, "skip-compilation", false, "Skip the compilation of the proto files and instead use the provided descriptor set to look up the files to lint. When using this flag, the provided descriptor set must contain the files to be linted and should have been compiled with --include_source_info and --include_imports.")
 	fs.StringArrayVar(&ruleEnableFlag, "enable-rule", nil, "Enable a rule with the given name.\nMay be specified multiple times.")
 	fs.StringArrayVar(&ruleDisableFlag, "disable-rule", nil, "Disable a rule with the given name.\nMay be specified multiple times.")
-	fs.BoolVar(&listRulesFlag, "list-rules", false, "Print the rules and exit. Honors the output-format flag.")
+	fs.BoolVar(&listRulesFlag, "list-rules", false, "Print the rules with their descriptions and exit.\nHonors the output-format flag.")
 	fs.BoolVar(&debugFlag, "debug", false, "Run in debug mode. Panics will print stack.")
 	fs.BoolVar(&ignoreCommentDisablesFlag, "ignore-comment-disables", false, "If set to true, disable comments will be ignored.\nThis is helpful when strict enforcement of AIPs are necessary and\nproto definitions should not be able to disable checks.")
+	fs.StringVar(&baselineFlag, "baseline", "", "A baseline file of known problems to suppress.\nEntries that no longer match any problem are reported as stale.")
+	fs.StringVar(&writeBaselineFlag, "write-baseline", "", "Write the problems found to a baseline file, and suppress them.")
+	fs.BoolVar(&fixFlag, "fix", false, "Apply the suggested fixes to the proto files in place.\nThe remaining problems are reported.")
+	fs.BoolVar(&fixDryRunFlag, "fix-dry-run", false, "Print the suggested fixes as a unified diff, without changing any file.")
+	fs.BoolVar(&groupByDescriptorFlag, "group-by-descriptor", false, "Group the problems of each file by descriptor.\nBy default, problems are sorted by position, then by rule.")
+	fs.StringVar(&diffBaseFlag, "diff-base", "", "Only report the problems on the lines changed relative to the given git ref.")
+	fs.StringVar(&diffFileFlag, "diff-file", "", "Only report the problems on the lines changed by the given unified diff.\nPaths in the diff are relative to the current directory.")
+	fs.BoolVar(&reportUnusedDisablesFlag, "report-unused-disables", false, "Report the disable comments that did not suppress any problem,\nand the disable comments that name unknown rules.")
+	fs.StringVar(&bufWorkspaceFlag, "buf-workspace", "", "A directory with a buf.work.yaml or buf.yaml file. The roots of its modules are\nadded to the proto paths, and its excludes and lint.ignore_only entries to the configs.\nThe entries of buf's own rules are skipped, and dependencies are only read from the buf cache;\ngive vendored dependencies with --proto-path or --descriptor-set-in.")
+	fs.StringVar(&bufCacheDirFlag, "buf-cache-dir", "", "The buf cache directory to look up the dependencies of the buf workspace in.\nBy default, the cache directory of buf.")
+	fs.StringArrayVar(&pluginFlag, "plugin", nil, "An executable that provides additional rules.\nMay be specified multiple times.")
+	fs.BoolVar(&watchFlag, "watch", false, "Keep running, and lint the files again when the proto files or the configs change.\nOnly the files affected by a change are compiled again.")
+	fs.IntVar(&concurrencyFlag, "concurrency", 0, "The number of checks to run at the same time, where a check is one rule\nrun against one file. By default, one per available CPU.")
 
 	// Parse flags.
 	err := fs.Parse(args)
@@ -103,6 +142,7 @@ func newCli(args []string) *cli {
 		FormatType:                fmtFlag,
 		OutputPath:                outFlag,
 		ExitStatusOnLintFailure:   setExitStatusOnLintFailure,
+		ExitStatusSeverity:        exitStatusSeverityFlag,
 		ProtoImportPaths:          protoImportFlag,
 		ProtoDescPath:             protoDescFlag,
 		SkipCompilationFlag:       skipCompilationFlag,
@@ -113,6 +153,19 @@ func newCli(args []string) *cli {
 		ListRulesFlag:             listRulesFlag,
 		DebugFlag:                 debugFlag,
 		IgnoreCommentDisablesFlag: ignoreCommentDisablesFlag,
+		BaselinePath:              baselineFlag,
+		WriteBaselinePath:         writeBaselineFlag,
+		FixFlag:                   fixFlag,
+		FixDryRunFlag:             fixDryRunFlag,
+		Concurrency:               concurrencyFlag,
+		GroupByDescriptorFlag:     groupByDescriptorFlag,
+		DiffBase:                  diffBaseFlag,
+		DiffFilePath:              diffFileFlag,
+		Plugins:                   pluginFlag,
+		ReportUnusedDisablesFlag:  reportUnusedDisablesFlag,
+		BufWorkspace:              bufWorkspaceFlag,
+		BufCacheDir:               bufCacheDirFlag,
+		WatchFlag:                 watchFlag,
 	}
 }
 
@@ -123,49 +176,121 @@ func (c *cli) lint(rules lint.RuleRegistry, configs lint.Configs) error {
 		return nil
 	}
 
+	// Add the modules of the buf workspace to the import paths and the
+	// configs.
+	configs, err := c.useBufWorkspace(configs)
+	if err != nil {
+		return err
+	}
+
 	if c.ListRulesFlag {
-		return outputRules(c.FormatType)
+		rules, _, err := c.loadRules(rules, configs)
+		if err != nil {
+			return err
+		}
+		return outputRules(rules, c.FormatType)
 	}
 
 	// Pre-check if there are files to lint.
 	if len(c.ProtoFiles) == 0 {
 		return fmt.Errorf("no file to lint")
 	}
-	// Read linter config and append it to the default.
-	if c.ConfigPath != "" {
-		config, err := lint.ReadConfigsFromFile(c.ConfigPath)
-		if err != nil {
+	exitStatusSeverity := lint.SeverityInfo
+	if c.ExitStatusSeverity != "" {
+		var err error
+		if exitStatusSeverity, err = lint.ParseSeverity(c.ExitStatusSeverity); err != nil {
 			return err
 		}
-		configs = append(configs, config...)
 	}
-	// Add configs for the enabled and disabled rules from flags.
-	// Combine them into a single config so that enable/disable
-	// precedence is handled correctly.
-	if len(c.EnabledRules) > 0 || len(c.DisabledRules) > 0 {
-		configs = append(configs, lint.Config{
-			EnabledRules:  c.EnabledRules,
-			DisabledRules: c.DisabledRules,
-		})
+	if c.DiffBase != "" && c.DiffFilePath != "" {
+		return fmt.Errorf("--diff-base and --diff-file can not be used together")
+	}
+	if c.BaselinePath != "" && c.WriteBaselinePath != "" {
+		return fmt.Errorf("--baseline and --write-baseline can not be used together")
+	}
+	if c.WatchFlag {
+		if c.FixFlag || c.FixDryRunFlag || c.SkipCompilationFlag {
+			return fmt.Errorf("--watch can not be used with --fix, --fix-dry-run or --skip-compilation")
+		}
+		return c.watch(rules, configs, exitStatusSeverity, nil)
 	}
+	return c.lintOnce(rules, configs, exitStatusSeverity, nil)
+}
 
-	var fileDescriptors []protoreflect.FileDescriptor
-	var err error
-	if c.SkipCompilationFlag {
-		fileDescriptors, err = c.getDescriptorsFromDescriptorSet()
-	} else {
-		fileDescriptors, err = c.getDescriptorsFromSource()
+// lintOnce lints the files and writes the results. The linked files of the
+// cache, if any, are reused, and the files compiled are added to it.
+func (c *cli) lintOnce(rules lint.RuleRegistry, configs lint.Configs, exitStatusSeverity lint.Severity, linked *linkCache) error {
+	// Expand the directories and glob patterns into the files to lint. The
+	// configs are discovered from all of them, but may then leave some out.
+	inputs, err := c.expandProtoFiles()
+	if err != nil {
+		return err
 	}
+	c.ProtoFiles = protoFileNames(inputs, nil)
+	rules, configs, err = c.loadRules(rules, configs)
 	if err != nil {
 		return err
 	}
+	if c.ProtoFiles = protoFileNames(inputs, configs); len(c.ProtoFiles) == 0 {
+		return fmt.Errorf("no file to lint: all the files are ignored by the configs")
+	}
 
-	// Create a linter to lint the file descriptors.
-	l := lint.New(rules, configs, lint.Debug(c.DebugFlag), lint.IgnoreCommentDisables(c.IgnoreCommentDisablesFlag))
-	results, err := l.LintProtos(fileDescriptors...)
-	if err != nil {
+	// Lint the files, fixing them first if asked.
+	var results []lint.Response
+	var overlay sourceOverlay
+	if c.FixFlag || c.FixDryRunFlag {
+		if c.SkipCompilationFlag {
+			return fmt.Errorf("--fix and --fix-dry-run can not be used with --skip-compilation")
+		}
+		results, overlay, err = c.fix(rules, configs)
+	} else {
+		results, err = c.lintFiles(rules, configs, nil, linked)
+	}
+	// Report the compile errors as problems along with the results of the
+	// other files, and fail once the results are written.
+	var cerr *compileError
+	if errors.As(err, &cerr) {
+		results = append(results, cerr.responses()...)
+	}
+	if _, ok := err.(*compileError); err != nil && !ok {
 		return err
 	}
+	if c.FixFlag {
+		if err := overlay.write(); err != nil {
+			return err
+		}
+	}
+
+	// Record the problems in a baseline if asked, and suppress the
+	// problems that are already known.
+	var baseline []baselineEntry
+	switch {
+	case c.WriteBaselinePath != "":
+		baseline = createBaseline(results)
+		if err := writeBaseline(c.WriteBaselinePath, baseline); err != nil {
+			return err
+		}
+	case c.BaselinePath != "":
+		if baseline, err = readBaseline(c.BaselinePath); err != nil {
+			return err
+		}
+	}
+	if baseline != nil {
+		var stale []baselineEntry
+		results, stale = applyBaseline(results, baseline)
+		for _, e := range stale {
+			fmt.Fprintf(os.Stderr, "stale baseline entry: %s: %s (%s)\n", e.FilePath, e.RuleID, e.Descriptor)
+		}
+	}
+
+	// Only report the problems on the changed lines if asked.
+	if c.DiffBase != "" || c.DiffFilePath != "" {
+		changed, err := c.readChangedLines()
+		if err != nil {
+			return err
+		}
+		results = c.filterByDiff(results, changed)
+	}
 
 	// Determine the output for writing the results.
 	// Stdout is the default output.
@@ -179,9 +304,26 @@ func (c *cli) lint(rules lint.RuleRegistry, configs lint.Configs) error {
 		defer w.Close()
 	}
 
+	// Print the fixes as a diff instead of the results in a dry run.
+	if c.FixDryRunFlag {
+		diff, err := overlay.diff()
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(diff)
+		return err
+	}
+
 	// Determine the format for printing the results.
-	// YAML format is the default.
+	// YAML format is the default. The text format reads the sources of the
+	// problems, and uses colors on a terminal. The SARIF format also reads
+	// them, and describes the enabled rules.
 	marshal := getOutputFormatFunc(c.FormatType)
+	if isTextFormat(c.FormatType) {
+		marshal = c.textFormatFunc(w, overlay)
+	} else if strings.EqualFold(c.FormatType, "sarif") {
+		marshal = c.sarifFormatFunc(rules, configs, overlay)
+	}
 
 	// Print the results.
 	b, err := marshal(results)
@@ -192,15 +334,132 @@ func (c *cli) lint(rules lint.RuleRegistry, configs lint.Configs) error {
 		return err
 	}
 
+	if cerr != nil {
+		return cerr
+	}
+
 	// Return error on lint failure which subsequently
 	// exits with a non-zero status code
-	if c.ExitStatusOnLintFailure && anyProblems(results) {
+	if c.ExitStatusOnLintFailure && anyProblems(results, exitStatusSeverity) {
 		return ExitForLintFailure
 	}
 
 	return nil
 }
 
+// loadRules loads the configs, and returns the rules along with the rules
+// that the configs and the plugins provide. The config files are validated
+// against the rules.
+func (c *cli) loadRules(rules lint.RuleRegistry, configs lint.Configs) (lint.RuleRegistry, lint.Configs, error) {
+	configs, err := c.loadConfigs(configs)
+	if err != nil {
+		return nil, nil, err
+	}
+	paths, err := c.configFiles()
+	if err != nil {
+		return nil, nil, err
+	}
+	// The fields and paths of the configs are checked before their custom
+	// rules are built and the plugins are started, and the rule names once
+	// these rules are registered.
+	if err := validateConfigFiles(paths, lint.ValidateConfigFileFields); err != nil {
+		return nil, nil, err
+	}
+	if rules, err = c.withExtraRules(rules, configs); err != nil {
+		return nil, nil, err
+	}
+	if err := validateConfigFiles(paths, func(path string) error { return lint.ValidateConfigFile(path, rules) }); err != nil {
+		return nil, nil, err
+	}
+	return rules, configs, nil
+}
+
+// validateConfigFiles checks the config files, and returns their errors
+// together.
+func validateConfigFiles(paths []string, validate func(path string) error) error {
+	var errs []error
+	for _, path := range paths {
+		if err := validate(path); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("invalid config:\n%w", err)
+	}
+	return nil
+}
+
+// loadConfigs appends the configs from the config file, or from the config
+// files discovered next to the files to lint, and from the rule flags to the
+// given configs.
+func (c *cli) loadConfigs(configs lint.Configs) (lint.Configs, error) {
+	// Read linter config and append it to the default.
+	if c.ConfigPath != "" {
+		config, err := lint.ReadConfigsFromFile(c.ConfigPath)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, config...)
+	} else {
+		discovered, err := c.discoverConfigs()
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, discovered...)
+	}
+	// Add configs for the enabled and disabled rules from flags.
+	// Combine them into a single config so that enable/disable
+	// precedence is handled correctly.
+	if len(c.EnabledRules) > 0 || len(c.DisabledRules) > 0 {
+		configs = append(configs, lint.Config{
+			EnabledRules:  c.EnabledRules,
+			DisabledRules: c.DisabledRules,
+		})
+	}
+	return configs, nil
+}
+
+// lintFiles compiles (or loads) the files to lint, and lints them.
+//
+// When compiling from source, the overlay takes precedence over the contents
+// of the files on disk, and the files of the link cache, if any, are reused.
+func (c *cli) lintFiles(rules lint.RuleRegistry, configs lint.Configs, overlay sourceOverlay, linked *linkCache) ([]lint.Response, error) {
+	var fileDescriptors []protoreflect.FileDescriptor
+	var err error
+	if c.SkipCompilationFlag {
+		fileDescriptors, err = c.getDescriptorsFromDescriptorSet()
+	} else {
+		fileDescriptors, err = c.getDescriptorsFromSource(overlay, linked)
+	}
+	// The files that failed to compile are reported with the results of the
+	// other files.
+	var cerr *compileError
+	if err != nil && !errors.As(err, &cerr) {
+		return nil, err
+	}
+
+	// Create a linter to lint the file descriptors.
+	l := lint.New(
+		rules,
+		configs,
+		lint.Debug(c.DebugFlag),
+		lint.IgnoreCommentDisables(c.IgnoreCommentDisablesFlag),
+		lint.Concurrency(c.Concurrency),
+		lint.GroupByDescriptor(c.GroupByDescriptorFlag),
+		lint.ReportUnusedDisables(c.ReportUnusedDisablesFlag),
+	)
+	results, err := l.LintProtos(fileDescriptors...)
+	switch {
+	case cerr == nil:
+		return results, err
+	case err == nil:
+		return results, cerr
+	default:
+		// The compile errors are kept along with the error of the linter.
+		return results, errors.Join(err, cerr)
+	}
+}
+
 func (c *cli) getDescriptorsFromDescriptorSet() ([]protoreflect.FileDescriptor, error) {
 	if len(c.ProtoDescPath) == 0 {
 		return nil, fmt.Errorf("no descriptor set found")
@@ -231,7 +490,7 @@ func (c *cli) getDescriptorsFromDescriptorSet() ([]protoreflect.FileDescriptor,
 	return fileDescriptors, nil
 }
 
-func (c *cli) getDescriptorsFromSource() ([]protoreflect.FileDescriptor, error) {
+func (c *cli) getDescriptorsFromSource(overlay sourceOverlay, linked *linkCache) ([]protoreflect.FileDescriptor, error) {
 	// Create resolver for descriptor sets.
 	descResolver, err := loadFileDescriptorsAsResolver(c.ProtoDescPath...)
 	if err != nil {
@@ -242,6 +501,7 @@ func (c *cli) getDescriptorsFromSource() ([]protoreflect.FileDescriptor, error)
 	imports := resolveImports(c.ProtoImportPaths)
 	sourceResolver := &protocompile.SourceResolver{
 		ImportPaths: imports,
+		Accessor:    overlay.open,
 	}
 
 	// This combines resolvers, prioritizing the source resolver and falling
@@ -252,68 +512,67 @@ func (c *cli) getDescriptorsFromSource() ([]protoreflect.FileDescriptor, error)
 		resolvers = append(resolvers, descResolver)
 	}
 
-	// The previous parser (`jhump/protoreflect`) reported all parse errors it
-	// found. The default behavior of the new parser (`protocompile`) is to
-	// stop on the first error.
-	//
-	// To preserve the original behavior, we provide a custom reporter that
-	// collects all errors and allows the compiler to continue. The previous
-	// parser also had no distinct concept of warnings, so we pass a nil
-	// warning handler to maintain the same behavior of ignoring them.
-	var collectedErrors []error
-	rep := reporter.NewReporter(func(err reporter.ErrorWithPos) error {
-		collectedErrors = append(collectedErrors, err)
-		return nil // Returning nil signals the compiler to continue.
-	}, nil)
-
-	compiler := protocompile.Compiler{
-		Resolver:       protocompile.WithStandardImports(protocompile.CompositeResolver(resolvers)),
-		SourceInfoMode: protocompile.SourceInfoExtraOptionLocations,
-		Reporter:       rep,
-	}
-
-	// Compile each file individually to avoid possible collisions
-	// between a linted file that imports other files that are also being linted.
-	// Otherwise, both the import resolver and the file will be "duplicated".
-	var compiledFiles linker.Files
-	for _, protoFile := range c.ProtoFiles {
-		// The compiler returns a slice of files, even for a single input file.
-		f, err := compiler.Compile(context.Background(), protoFile)
-		// After compilation, check if the handler collected any errors.
-		// This is the primary source of truth for parse errors when using a
-		// custom reporter that continues on error.
-		if len(collectedErrors) > 0 {
-			errorStrings := make([]string, len(collectedErrors))
-			for i, e := range collectedErrors {
-				errorStrings[i] = e.Error()
-			}
-			return nil, errors.New(strings.Join(errorStrings, "\n"))
+	resolver := protocompile.WithStandardImports(protocompile.CompositeResolver(resolvers))
+	if linked != nil {
+		resolver = linked.resolver(resolver)
+	}
+
+	// Compile the files at once, so that the imports that they share are
+	// only parsed once. A file given by another name than the one that imports
+	// use, relative to the innermost proto path, is compiled on its own:
+	// another file may import it, which would define its symbols twice.
+	var batches [][]string
+	var shared []string
+	compiled := map[string]protoreflect.FileDescriptor{}
+	for _, name := range c.ProtoFiles {
+		if fd, ok := linked.find(name); ok {
+			compiled[name] = fd
+		} else if abs, ok := c.findOnDisk(name); ok && protoFileName(abs, imports) != filepath.ToSlash(name) {
+			batches = append(batches, []string{name})
+		} else {
+			shared = append(shared, name)
 		}
+	}
+	if len(shared) > 0 {
+		batches = append([][]string{shared}, batches...)
+	}
 
-		// If the reporter has no errors, but the compiler still returned one,
-		// it's a fatal, non-recoverable error.
+	// The files that fail to compile are left out, and their errors are
+	// returned along with the other files.
+	cerr := &compileError{}
+	for _, batch := range batches {
+		files, err := compileEach(resolver, batch, cerr)
 		if err != nil {
 			return nil, err
 		}
-		// Append the compiled file(s) to the slice.
-		compiledFiles = append(compiledFiles, f...)
+		for path, fd := range files {
+			compiled[path] = fd
+			linked.add(fd)
+		}
 	}
-	files := compiledFiles
 
+	// Return the files in the order that they were given.
 	var fileDescriptors []protoreflect.FileDescriptor
-	// The compiler returns a slice of `*linker.File`, which is the compiler's
-	// internal representation. We convert this to a slice of the standard
-	// `protoreflect.FileDescriptor` interface, which the linter engine expects.
-	for _, f := range files {
-		fileDescriptors = append(fileDescriptors, f)
+	for _, name := range c.ProtoFiles {
+		if fd, ok := compiled[name]; ok {
+			fileDescriptors = append(fileDescriptors, fd)
+			delete(compiled, name)
+		}
+	}
+	if len(cerr.errs) > 0 {
+		return fileDescriptors, cerr
 	}
 	return fileDescriptors, nil
 }
 
-func anyProblems(results []lint.Response) bool {
+// anyProblems returns true if any of the results contains a problem that is
+// at least as serious as the given severity.
+func anyProblems(results []lint.Response, severity lint.Severity) bool {
 	for i := range results {
-		if len(results[i].Problems) > 0 {
-			return true
+		for _, p := range results[i].Problems {
+			if p.Severity.AtLeast(severity) {
+				return true
+			}
 		}
 	}
 	return false
@@ -406,6 +665,9 @@ var outputFormatFuncs = map[string]formatFunc{
 			return json.Marshal(v)
 		}
 	},
+	"sarif":  sarifFormatter{source: os.ReadFile}.formatFunc(),
+	"text":   textFormatter{source: os.ReadFile}.formatFunc(),
+	"pretty": textFormatter{source: os.ReadFile}.formatFunc(),
 	"summary": func(i interface{}) ([]byte, error) {
 		switch v := i.(type) {
 		case []lint.Response:
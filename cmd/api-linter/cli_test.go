@@ -1,12 +1,16 @@
 package main
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/api-linter/v2/lint"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 func TestNewCli(t *testing.T) {
@@ -25,16 +29,33 @@ func TestNewCli(t *testing.T) {
 				"--descriptor-set-in=proto_desc2",
 				"--proto-path=proto_path_a",
 				"-I=proto_path_b",
+				"--concurrency=4",
+				"--group-by-descriptor",
+				"--diff-file=changes.diff",
+				"--plugin=plugin_a",
+				"--plugin=plugin_b",
+				"--report-unused-disables",
+				"--buf-workspace=workspace",
+				"--buf-cache-dir=cache",
+				"--watch",
 				"a.proto",
 				"b.proto",
 			},
 			wantCli: &cli{
-				ConfigPath:       "config",
-				OutputPath:       "out",
-				FormatType:       "json",
-				ProtoDescPath:    []string{"proto_desc1", "proto_desc2"},
-				ProtoImportPaths: []string{"proto_path_a", "proto_path_b"},
-				ProtoFiles:       []string{"a.proto", "b.proto"},
+				ConfigPath:               "config",
+				OutputPath:               "out",
+				FormatType:               "json",
+				ProtoDescPath:            []string{"proto_desc1", "proto_desc2"},
+				ProtoImportPaths:         []string{"proto_path_a", "proto_path_b"},
+				ProtoFiles:               []string{"a.proto", "b.proto"},
+				Concurrency:              4,
+				GroupByDescriptorFlag:    true,
+				DiffFilePath:             "changes.diff",
+				Plugins:                  []string{"plugin_a", "plugin_b"},
+				ReportUnusedDisablesFlag: true,
+				BufWorkspace:             "workspace",
+				BufCacheDir:              "cache",
+				WatchFlag:                true,
 			},
 		},
 		{
@@ -199,3 +220,26 @@ func TestResolveImports(t *testing.T) {
 		})
 	}
 }
+
+func TestLintFilesCompileAndLintErrors(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"good.proto":   "syntax = \"proto3\";\npackage test;\nmessage Good {}\n",
+		"broken.proto": "syntax = \"proto3\";\npackage test;\nmessage Broken { Missing m = 1; }\n",
+	})
+	// The rule fails, since its problem has no descriptor.
+	rules := lint.NewRuleRegistry()
+	err := rules.Register(111, &lint.FileRule{
+		Name:     lint.NewRuleName(111, "test-rule"),
+		LintFile: func(protoreflect.FileDescriptor) []lint.Problem { return []lint.Problem{{Message: "m"}} },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &cli{ProtoImportPaths: []string{root}, ProtoFiles: []string{"good.proto", "broken.proto"}}
+	_, err = c.lintFiles(rules, nil, nil, nil)
+	var cerr *compileError
+	if !errors.As(err, &cerr) || !strings.Contains(err.Error(), "missing required Descriptor") {
+		t.Errorf("Got error %v, want the errors of the rule and of the compiler.", err)
+	}
+}
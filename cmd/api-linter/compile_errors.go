@@ -0,0 +1,240 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/bufbuild/protocompile/ast"
+	"github.com/bufbuild/protocompile/linker"
+	"github.com/bufbuild/protocompile/parser"
+	"github.com/bufbuild/protocompile/reporter"
+	"github.com/googleapis/api-linter/v2/lint"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// compileErrorRuleID is the rule ID of the problems that report compile
+// errors.
+const compileErrorRuleID lint.RuleName = "compile-error"
+
+// compileError is the error of the files that failed to compile, returned
+// along with the results of the other files.
+type compileError struct {
+	errs []reporter.ErrorWithPos
+}
+
+func (e *compileError) Error() string {
+	msgs := make([]string, len(e.errs))
+	for i, err := range e.errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// add adds errors, skipping those already reported.
+func (e *compileError) add(errs ...reporter.ErrorWithPos) {
+	for _, err := range errs {
+		duplicate := false
+		for _, other := range e.errs {
+			duplicate = duplicate || other.Error() == err.Error()
+		}
+		if !duplicate {
+			e.errs = append(e.errs, err)
+		}
+	}
+}
+
+// responses returns the compile errors as problems, one response for each
+// file with errors, sorted by path.
+func (e *compileError) responses() []lint.Response {
+	var paths []string
+	problems := map[string][]lint.Problem{}
+	for _, err := range e.errs {
+		pos := err.GetPosition()
+		if _, ok := problems[pos.Filename]; !ok {
+			paths = append(paths, pos.Filename)
+		}
+		// The problems are attached to an empty file with the path of the
+		// file with errors, since it could not be compiled.
+		fd, _ := protodesc.NewFile(&dpb.FileDescriptorProto{Name: proto.String(pos.Filename)}, nil)
+		line, col := int32(max(pos.Line-1, 0)), int32(max(pos.Col-1, 0))
+		problems[pos.Filename] = append(problems[pos.Filename], lint.Problem{
+			Message:    err.Unwrap().Error(),
+			Descriptor: fd,
+			Location:   &dpb.SourceCodeInfo_Location{Span: []int32{line, col, col + 1}},
+			RuleID:     compileErrorRuleID,
+			Severity:   lint.SeverityError,
+		})
+	}
+	sort.Strings(paths)
+	var responses []lint.Response
+	for _, path := range paths {
+		responses = append(responses, lint.Response{FilePath: path, Problems: problems[path]})
+	}
+	return responses
+}
+
+// compileFiles compiles files in a single pass. The compile errors are
+// returned apart from the other errors, which are fatal.
+func compileFiles(resolver protocompile.Resolver, names []string) (linker.Files, []reporter.ErrorWithPos, error) {
+	// The previous parser (`jhump/protoreflect`) reported all parse errors it
+	// found. The default behavior of the new parser (`protocompile`) is to
+	// stop on the first error.
+	//
+	// To preserve the original behavior, we provide a custom reporter that
+	// collects all errors and allows the compiler to continue. The previous
+	// parser also had no distinct concept of warnings, so we pass a nil
+	// warning handler to maintain the same behavior of ignoring them.
+	var errs []reporter.ErrorWithPos
+	rep := reporter.NewReporter(func(err reporter.ErrorWithPos) error {
+		errs = append(errs, err)
+		return nil // Returning nil signals the compiler to continue.
+	}, nil)
+
+	compiler := protocompile.Compiler{
+		Resolver:       resolver,
+		SourceInfoMode: protocompile.SourceInfoExtraOptionLocations,
+		Reporter:       rep,
+	}
+	files, err := compiler.Compile(context.Background(), names...)
+	// The reporter is the primary source of truth for the errors, since it
+	// lets the compiler continue. If it has no errors, but the compiler
+	// still returned one, it's a fatal, non-recoverable error.
+	if len(errs) > 0 {
+		return nil, errs, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return files, nil, nil
+}
+
+// compileEach compiles the files in a single pass, or, if some fail to
+// compile, the others without them. It returns the files that compiled.
+//
+// An error fails the whole pass, so the files without errors are compiled
+// again in a second pass, and one by one if they still fail (for example,
+// because an import has errors). A file that fails only because of the
+// errors of its imports gets an error of its own, which names them.
+func compileEach(resolver protocompile.Resolver, names []string, cerr *compileError) (map[string]protoreflect.FileDescriptor, error) {
+	compiled := map[string]protoreflect.FileDescriptor{}
+	last := names
+	files, errs, err := compileFiles(resolver, names)
+	if err != nil {
+		return nil, err
+	}
+	if len(errs) > 0 && len(names) > 1 {
+		cerr.add(errs...)
+		failed := map[string]bool{}
+		for _, e := range errs {
+			failed[e.GetPosition().Filename] = true
+		}
+		var rest []string
+		for _, name := range names {
+			if !failed[name] {
+				rest = append(rest, name)
+			}
+		}
+		last = rest
+		if files, errs, err = compileFiles(resolver, rest); err != nil {
+			return nil, err
+		}
+		if len(errs) > 0 && len(rest) > 1 {
+			for _, name := range rest {
+				one, err := compileEach(resolver, []string{name}, cerr)
+				if err != nil {
+					return nil, err
+				}
+				for path, fd := range one {
+					compiled[path] = fd
+				}
+			}
+			return compiled, nil
+		}
+	}
+	cerr.add(errs...)
+	if len(errs) > 0 && len(last) == 1 {
+		if err := importError(resolver, last[0], errs); err != nil {
+			cerr.add(err)
+		}
+	}
+	// The compiler returns a slice of `*linker.File`, which is the compiler's
+	// internal representation, and implements the standard
+	// `protoreflect.FileDescriptor` interface that the linter expects.
+	for _, f := range files {
+		compiled[f.Path()] = f
+	}
+	return compiled, nil
+}
+
+// importError returns the error of a file that failed to compile only
+// because of the errors of the files that it imports, at the import of the
+// first of them, if it imports it directly. It returns nil if the file has
+// errors of its own.
+func importError(resolver protocompile.Resolver, name string, errs []reporter.ErrorWithPos) reporter.ErrorWithPos {
+	var broken []string
+	for _, err := range errs {
+		path := err.GetPosition().Filename
+		if path == name {
+			return nil
+		}
+		if !slices.Contains(broken, path) {
+			broken = append(broken, path)
+		}
+	}
+	sort.Strings(broken)
+
+	span := ast.UnknownSpan(name)
+	if file := parseFile(resolver, name); file != nil {
+		for _, decl := range file.Decls {
+			if imp, ok := decl.(*ast.ImportNode); ok && imp.Name.AsString() == broken[0] {
+				span = file.NodeInfo(imp)
+				break
+			}
+		}
+	}
+	return reporter.Errorf(span, "imports files that failed to compile: %s", strings.Join(broken, ", "))
+}
+
+// parseFile returns the syntax tree of a file, or nil if it cannot be read
+// or parsed.
+func parseFile(resolver protocompile.Resolver, name string) *ast.FileNode {
+	result, err := resolver.FindFileByPath(name)
+	if err != nil {
+		return nil
+	}
+	if result.AST != nil {
+		return result.AST
+	}
+	if result.Source == nil {
+		return nil
+	}
+	if c, ok := result.Source.(io.Closer); ok {
+		defer c.Close()
+	}
+	file, err := parser.Parse(name, result.Source, reporter.NewHandler(nil))
+	if err != nil {
+		return nil
+	}
+	return file
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/googleapis/api-linter/v2/lint"
+)
+
+// configFileNames are the names of the config files that are discovered when
+// no config file is given with --config.
+var configFileNames = []string{"api-linter.yaml", ".api-linter.yaml", ".api-linter.json"}
+
+// discoveredFile is a file to lint, along with its path on disk.
+type discoveredFile struct {
+	// name is the path of the file in the linter, relative to its import
+	// path.
+	name string
+	// abs is the absolute path of the file on disk.
+	abs string
+}
+
+// discoverConfigs returns the configs of the config files found in the
+// directories of the files to lint and in their parent directories, up to
+// the root of the repository.
+//
+// The config files are merged from the outermost to the innermost, so that
+// the configs of a subtree take precedence over those of the repository. The
+// paths in a config file are relative to its directory, and a config file
+// only applies to the files under its directory.
+func (c *cli) discoverConfigs() (lint.Configs, error) {
+	paths, files, err := c.discoverConfigFiles()
+	if err != nil {
+		return nil, err
+	}
+	var configs lint.Configs
+	for _, path := range paths {
+		fileConfigs, err := lint.ReadConfigsFromFile(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, config := range fileConfigs {
+			if scoped, ok := scopeConfig(config, filepath.Dir(path), files[path]); ok {
+				configs = append(configs, scoped)
+			}
+		}
+	}
+	return configs, nil
+}
+
+// discoverConfigFiles returns the paths of the config files that apply to the
+// files to lint, from the outermost to the innermost, along with the files
+// that each of them applies to.
+func (c *cli) discoverConfigFiles() ([]string, map[string][]discoveredFile, error) {
+	files := map[string][]discoveredFile{}
+	for _, name := range c.ProtoFiles {
+		abs, ok := c.findOnDisk(name)
+		if !ok {
+			continue
+		}
+		configPaths, err := findConfigFiles(filepath.Dir(abs))
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, path := range configPaths {
+			files[path] = append(files[path], discoveredFile{name: name, abs: abs})
+		}
+	}
+
+	var paths []string
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		di, dj := strings.Count(paths[i], string(filepath.Separator)), strings.Count(paths[j], string(filepath.Separator))
+		if di != dj {
+			return di < dj
+		}
+		return paths[i] < paths[j]
+	})
+	return paths, files, nil
+}
+
+// configFiles returns the paths of the config files in use: the one given
+// with --config, or the discovered ones.
+func (c *cli) configFiles() ([]string, error) {
+	if c.ConfigPath != "" {
+		return []string{c.ConfigPath}, nil
+	}
+	paths, _, err := c.discoverConfigFiles()
+	return paths, err
+}
+
+// findOnDisk returns the absolute path of a file to lint, searching the
+// import paths in order, like the compiler.
+func (c *cli) findOnDisk(name string) (string, bool) {
+	for _, importPath := range resolveImports(c.ProtoImportPaths) {
+		path := filepath.Join(importPath, name)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			abs, err := filepath.Abs(path)
+			return abs, err == nil
+		}
+	}
+	return "", false
+}
+
+// findConfigFiles returns the config files in a directory and its parents,
+// up to the root of the repository (the first directory with a .git entry)
+// or of the file system.
+func findConfigFiles(dir string) ([]string, error) {
+	var paths []string
+	for {
+		var found []string
+		for _, name := range configFileNames {
+			path := filepath.Join(dir, name)
+			if _, err := os.Stat(path); err == nil {
+				found = append(found, path)
+			}
+		}
+		if len(found) > 1 {
+			return nil, fmt.Errorf("multiple config files in %s: %s", dir, strings.Join(found, ", "))
+		}
+		paths = append(paths, found...)
+
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return paths, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return paths, nil
+		}
+		dir = parent
+	}
+}
+
+// scopeConfig returns a config that only applies to the files, among those
+// under the directory of its config file, that it matches. It returns false
+// if it matches none of them.
+//
+// The paths of the config are relative to the directory of its config file,
+// while the linter matches the paths relative to the import paths. The
+// config is thus rewritten to include (and ignore) the files that it matches
+// by name.
+func scopeConfig(config lint.Config, dir string, files []discoveredFile) (lint.Config, bool) {
+	var included, ignored []string
+	for _, f := range files {
+		rel, err := filepath.Rel(dir, f.abs)
+		if err != nil {
+			continue
+		}
+		if config.MatchesPath(rel) {
+			included = append(included, f.name)
+			if (lint.Configs{{IgnoredPaths: config.IgnoredPaths}}).IsPathIgnored(rel) {
+				ignored = append(ignored, f.name)
+			}
+		}
+	}
+	if len(included) == 0 {
+		return config, false
+	}
+	config.IncludedPaths = included
+	config.ExcludedPaths = nil
+	config.IgnoredPaths = ignored
+	return config, true
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/api-linter/v2/lint"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestDiscoverConfigs(t *testing.T) {
+	tmp := t.TempDir()
+	root := filepath.Join(tmp, "repo")
+	writeFiles(t, tmp, map[string]string{
+		// Outside of the repository, so never read.
+		"api-linter.yaml":                           "not a config",
+		"repo/.git/HEAD":                            "",
+		"repo/api-linter.yaml":                      "- disabled_rules: ['core::0140']\n  rule_severities: {'core::0131': warning}\n",
+		"repo/protos/team/.api-linter.json":         `[{"included_paths": ["b.proto"], "enabled_rules": ["core::0140"]}, {"included_paths": ["c.proto"]}]`,
+		"repo/protos/team/a.proto":                  "",
+		"repo/protos/team/b.proto":                  "",
+		"repo/protos/other/c.proto":                 "",
+		"repo/protos/other/.api-linter.yaml":        "- excluded_paths: ['c.proto']\n  disabled_rules: ['core::0131']\n",
+		"repo/protos/unused/.api-linter.yaml":       "- disabled_rules: ['all']\n",
+		"repo/protos/unused/not_linted/d.proto":     "",
+		"repo/protos/unused/not_linted/other.proto": "",
+	})
+
+	c := &cli{
+		ProtoImportPaths: []string{filepath.Join(root, "protos")},
+		ProtoFiles:       []string{"team/a.proto", "team/b.proto", "other/c.proto"},
+	}
+	got, err := c.discoverConfigs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := lint.Configs{
+		{
+			IncludedPaths:  []string{"team/a.proto", "team/b.proto", "other/c.proto"},
+			DisabledRules:  []string{"core::0140"},
+			RuleSeverities: map[string]lint.Severity{"core::0131": lint.SeverityWarning},
+		},
+		{
+			IncludedPaths: []string{"team/b.proto"},
+			EnabledRules:  []string{"core::0140"},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("discoverConfigs() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDiscoverConfigs_MultipleFiles(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		".git/HEAD":        "",
+		"api-linter.yaml":  "[]",
+		".api-linter.json": "[]",
+		"a.proto":          "",
+	})
+	c := &cli{ProtoImportPaths: []string{root}, ProtoFiles: []string{"a.proto"}}
+	if _, err := c.discoverConfigs(); err == nil || !strings.Contains(err.Error(), "multiple config files") {
+		t.Errorf("discoverConfigs() returned error %v, want multiple config files", err)
+	}
+}
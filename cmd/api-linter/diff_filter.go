@@ -0,0 +1,114 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/googleapis/api-linter/v2/lint"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// readChangedLines returns the lines changed relative to the git ref given
+// with --diff-base, or by the unified diff given with --diff-file.
+//
+// The paths of the changed files are relative to the current directory.
+func (c *cli) readChangedLines() (changedLines, error) {
+	if c.DiffFilePath != "" {
+		f, err := os.Open(c.DiffFilePath)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return parseUnifiedDiff(f)
+	}
+	// --relative makes the paths relative to the current directory, and
+	// -U0 drops the unchanged lines, which are not needed.
+	cmd := exec.Command("git", "diff", "-U0", "--no-color", "--no-ext-diff", "--relative", c.DiffBase, "--")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git diff %s: %v: %s", c.DiffBase, err, bytes.TrimSpace(stderr.Bytes()))
+	}
+	return parseUnifiedDiff(bytes.NewReader(out))
+}
+
+// filterByDiff keeps the problems on the changed lines.
+//
+// Problems without a span, such as the problems reported on a whole file,
+// are kept if the file changed at all. The files that did not change have no
+// problems left.
+func (c *cli) filterByDiff(results []lint.Response, changed changedLines) []lint.Response {
+	// Key the changes by absolute path, to match them with the files on disk.
+	byPath := map[string]map[int]bool{}
+	for path, lines := range changed {
+		byPath[absPath(path)] = lines
+	}
+
+	filtered := make([]lint.Response, 0, len(results))
+	for _, r := range results {
+		diskPath, ok := c.sourcePath(r.FilePath, nil)
+		if !ok {
+			diskPath = r.FilePath
+		}
+		lines, ok := byPath[absPath(diskPath)]
+		problems := []lint.Problem{}
+		if ok {
+			for _, p := range r.Problems {
+				if problemChanged(p, lines) {
+					problems = append(problems, p)
+				}
+			}
+		}
+		r.Problems = problems
+		filtered = append(filtered, r)
+	}
+	return filtered
+}
+
+// problemChanged returns whether the span of a problem contains one of the
+// changed lines, which are one-based.
+func problemChanged(p lint.Problem, lines map[int]bool) bool {
+	// A file descriptor spans the whole file.
+	if _, ok := p.Descriptor.(protoreflect.FileDescriptor); ok && p.Location == nil {
+		return true
+	}
+	span := problemSpan(p)
+	if len(span) < 3 {
+		return true
+	}
+	start, end := int(span[0])+1, int(span[0])+1
+	if len(span) == 4 {
+		end = int(span[2]) + 1
+	}
+	for line := start; line <= end; line++ {
+		if lines[line] {
+			return true
+		}
+	}
+	return false
+}
+
+func absPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return filepath.Clean(path)
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/api-linter/v2/lint"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestParseUnifiedDiff(t *testing.T) {
+	diff := strings.Join([]string{
+		"diff --git a/a.proto b/a.proto",
+		"--- a/a.proto",
+		"+++ b/a.proto",
+		"@@ -2,0 +3,2 @@ message Foo {",
+		"+  string bar = 1;",
+		"+  string baz = 2;",
+		"@@ -10 +12 @@",
+		"-message Old {}",
+		"+message New {}",
+		"--- a/removed_lines.proto",
+		"+++ b/removed_lines.proto",
+		"@@ -4,2 +3,0 @@",
+		"-  string bar = 1;",
+		"-  string baz = 2;",
+		"--- a/deleted.proto",
+		"+++ /dev/null",
+		"@@ -1 +0,0 @@",
+		"-syntax = \"proto3\";",
+		"--- /dev/null\t2026-01-01 00:00:00",
+		"+++ new.proto\t2026-01-01 00:00:00",
+		"@@ -0,0 +1,3 @@",
+		"+syntax = \"proto3\";",
+		"+",
+		"+package foo;",
+		"\\ No newline at end of file",
+		"",
+	}, "\n")
+	got, err := parseUnifiedDiff(strings.NewReader(diff))
+	if err != nil {
+		t.Fatalf("parseUnifiedDiff() returned error: %v", err)
+	}
+	want := changedLines{
+		"a.proto":             {3: true, 4: true, 12: true},
+		"removed_lines.proto": {},
+		"new.proto":           {1: true, 2: true, 3: true},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("parseUnifiedDiff() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseUnifiedDiff_InvalidHunk(t *testing.T) {
+	if _, err := parseUnifiedDiff(strings.NewReader("--- a/a.proto\n+++ b/a.proto\n@@ bad @@\n")); err == nil {
+		t.Errorf("parseUnifiedDiff() returned no error, want one")
+	}
+}
+
+func TestFilterByDiff(t *testing.T) {
+	dir := t.TempDir()
+	content := "syntax = \"proto3\";\n\npackage test;\n\nmessage Foo {\n  string bar = 1;\n}\n"
+	for _, name := range []string{"a.proto", "b.proto"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	compiler := protocompile.Compiler{
+		Resolver:       &protocompile.SourceResolver{ImportPaths: []string{dir}},
+		SourceInfoMode: protocompile.SourceInfoStandard,
+	}
+	files, err := compiler.Compile(context.Background(), "a.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd := files[0]
+	foo := fd.Messages().Get(0)
+
+	atLine := func(rule lint.RuleName, line int32) lint.Problem {
+		return lint.Problem{RuleID: rule, Location: &dpb.SourceCodeInfo_Location{Span: []int32{line - 1, 0, 5}}}
+	}
+	results := []lint.Response{
+		{
+			FilePath: "a.proto",
+			Problems: []lint.Problem{
+				atLine("unchanged", 3),
+				atLine("changed", 6),
+				// Spans lines 5 to 7.
+				{RuleID: "message", Descriptor: foo},
+				{RuleID: "file", Descriptor: fd},
+			},
+		},
+		{
+			FilePath: "b.proto",
+			Problems: []lint.Problem{atLine("changed", 6)},
+		},
+	}
+	changed := changedLines{filepath.Join(dir, "a.proto"): {6: true}}
+
+	c := &cli{ProtoImportPaths: []string{dir}}
+	var got []lint.RuleName
+	for _, r := range c.filterByDiff(results, changed) {
+		for _, p := range r.Problems {
+			got = append(got, lint.RuleName(r.FilePath+":")+p.RuleID)
+		}
+	}
+	want := []lint.RuleName{"a.proto:changed", "a.proto:message", "a.proto:file"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("filterByDiff() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,382 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/googleapis/api-linter/v2/lint"
+)
+
+// maxFixPasses bounds the number of times the files are linted again after
+// applying fixes, in case the fixes of different rules never converge.
+const maxFixPasses = 10
+
+// sourceOverlay holds modified contents of source files, keyed by their
+// path on disk.
+type sourceOverlay map[string][]byte
+
+// open returns the contents of a file from the overlay if present, or from
+// the disk otherwise. It can be used as a protocompile.SourceResolver accessor.
+func (o sourceOverlay) open(path string) (io.ReadCloser, error) {
+	if b, ok := o[filepath.Clean(path)]; ok {
+		return io.NopCloser(bytes.NewReader(b)), nil
+	}
+	return os.Open(path)
+}
+
+// read returns the contents of a file from the overlay if present, or from
+// the disk otherwise.
+func (o sourceOverlay) read(path string) ([]byte, error) {
+	if b, ok := o[filepath.Clean(path)]; ok {
+		return b, nil
+	}
+	return os.ReadFile(path)
+}
+
+// paths returns the paths of the files in the overlay, in a stable order.
+func (o sourceOverlay) paths() []string {
+	paths := make([]string, 0, len(o))
+	for path := range o {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// write writes the contents of the overlay back to the disk.
+func (o sourceOverlay) write() error {
+	for _, path := range o.paths() {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, o[path], info.Mode().Perm()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diff returns a unified diff between the files on disk and the overlay.
+func (o sourceOverlay) diff() ([]byte, error) {
+	var buf bytes.Buffer
+	for _, path := range o.paths() {
+		original, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString(unifiedDiff(filepath.ToSlash(path), original, o[path]))
+	}
+	return buf.Bytes(), nil
+}
+
+// fix lints the files and applies the suggested fixes to an overlay, then
+// lints the fixed files again until they no longer change.
+//
+// It returns the problems that remain, along with the fixed files.
+func (c *cli) fix(rules lint.RuleRegistry, configs lint.Configs) ([]lint.Response, sourceOverlay, error) {
+	overlay := sourceOverlay{}
+	for pass := 0; ; pass++ {
+		results, err := c.lintFiles(rules, configs, overlay, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		if pass == maxFixPasses {
+			c.warnPendingFixes(collectFixes(results), overlay)
+			return results, overlay, nil
+		}
+
+		changed, err := c.applyFixes(collectFixes(results), overlay)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !changed {
+			return results, overlay, nil
+		}
+	}
+}
+
+// warnPendingFixes prints a warning to stderr if the fixes would still change
+// the files once the passes are exhausted, naming their rules.
+func (c *cli) warnPendingFixes(fixes []pendingFix, overlay sourceOverlay) {
+	pending := sourceOverlay{}
+	for path, b := range overlay {
+		pending[path] = b
+	}
+	if changed, err := c.applyFixes(fixes, pending); err != nil || !changed {
+		return
+	}
+	seen := map[lint.RuleName]bool{}
+	var rules []string
+	for _, f := range fixes {
+		if !seen[f.ruleID] {
+			seen[f.ruleID] = true
+			rules = append(rules, string(f.ruleID))
+		}
+	}
+	sort.Strings(rules)
+	fmt.Fprintf(os.Stderr, "warning: fixes were still pending after %d passes, and were not applied: %s\n", maxFixPasses, strings.Join(rules, ", "))
+}
+
+// applyFixes applies the fixes to the overlay, and returns true if any file
+// changed.
+//
+// The fixes are considered in order, and a fix is skipped if any of its
+// edits overlaps an edit that was already accepted, or if it edits a file
+// that was not compiled from source (for example, a file from a descriptor
+// set). Skipped fixes are suggested again when the result is linted, if they
+// still apply.
+func (c *cli) applyFixes(fixes []pendingFix, overlay sourceOverlay) (bool, error) {
+	sources := map[string][]byte{}
+	accepted := map[string][]offsetEdit{}
+fixes:
+	for _, f := range fixes {
+		resolved := map[string][]offsetEdit{}
+		for _, e := range f.edits {
+			diskPath, ok := c.sourcePath(e.path, overlay)
+			if !ok {
+				continue fixes
+			}
+			src, ok := sources[diskPath]
+			if !ok {
+				var err error
+				if src, err = overlay.read(diskPath); err != nil {
+					return false, err
+				}
+				sources[diskPath] = src
+			}
+			oe, ok := e.resolve(src)
+			if !ok {
+				continue fixes
+			}
+			for _, other := range append(accepted[diskPath], resolved[diskPath]...) {
+				if oe.overlaps(other) {
+					continue fixes
+				}
+			}
+			resolved[diskPath] = append(resolved[diskPath], oe)
+		}
+		for path, edits := range resolved {
+			accepted[path] = append(accepted[path], edits...)
+		}
+	}
+
+	changed := false
+	for path, edits := range accepted {
+		if fixed := applyEdits(sources[path], edits); !bytes.Equal(fixed, sources[path]) {
+			overlay[path] = fixed
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
+// sourcePath returns the path on disk of a file to lint, by looking it up
+// in the import paths the same way the compiler does.
+func (c *cli) sourcePath(path string, overlay sourceOverlay) (string, bool) {
+	for _, importPath := range resolveImports(c.ProtoImportPaths) {
+		diskPath := filepath.Clean(filepath.Join(importPath, path))
+		if _, ok := overlay[diskPath]; ok {
+			return diskPath, true
+		}
+		if info, err := os.Stat(diskPath); err == nil && !info.IsDir() {
+			return diskPath, true
+		}
+	}
+	return "", false
+}
+
+// pendingFix is a fix suggested by a problem.
+type pendingFix struct {
+	ruleID lint.RuleName
+	edits  []textEdit
+}
+
+// textEdit is a replacement of a span of text in a source file.
+type textEdit struct {
+	// The path of the file, as known by the compiler.
+	path string
+	// The span being replaced, in the format of a SourceCodeInfo location:
+	// zero-based lines and columns, with an exclusive end.
+	span    []int32
+	newText string
+}
+
+// start and end return the positions of the edit as (line, column) pairs.
+func (e textEdit) start() (int, int) {
+	return int(e.span[0]), int(e.span[1])
+}
+
+func (e textEdit) end() (int, int) {
+	if len(e.span) == 4 {
+		return int(e.span[2]), int(e.span[3])
+	}
+	return int(e.span[0]), int(e.span[2])
+}
+
+// resolve converts the span of the edit into byte offsets in the source.
+func (e textEdit) resolve(src []byte) (offsetEdit, bool) {
+	lines := lineOffsets(src)
+	startLine, startCol := e.start()
+	endLine, endCol := e.end()
+	start, ok := byteOffset(src, lines, startLine, startCol)
+	if !ok {
+		return offsetEdit{}, false
+	}
+	end, ok := byteOffset(src, lines, endLine, endCol)
+	if !ok || end < start {
+		return offsetEdit{}, false
+	}
+	return offsetEdit{start: start, end: end, newText: e.newText}, true
+}
+
+// offsetEdit is a replacement of the bytes in [start, end) of a file.
+type offsetEdit struct {
+	start, end int
+	newText    string
+}
+
+// overlaps returns true if two edits can not both be applied. Two insertions
+// at the same position overlap, since their order would be ambiguous.
+func (e offsetEdit) overlaps(other offsetEdit) bool {
+	if e.start == e.end && other.start == other.end {
+		return e.start == other.start
+	}
+	return e.start < other.end && other.start < e.end
+}
+
+// collectFixes returns the first fix of every problem, in a stable order.
+func collectFixes(results []lint.Response) []pendingFix {
+	var fixes []pendingFix
+	for _, r := range results {
+		for _, p := range r.Problems {
+			pfs := p.GetFixes()
+			if len(pfs) == 0 {
+				continue
+			}
+			f := pendingFix{ruleID: p.RuleID}
+			for _, e := range pfs[0].Edits {
+				if span := e.Location.GetSpan(); len(span) == 3 || len(span) == 4 {
+					f.edits = append(f.edits, textEdit{path: e.FilePath, span: span, newText: e.NewText})
+				}
+			}
+			if len(f.edits) == len(pfs[0].Edits) && len(f.edits) > 0 {
+				fixes = append(fixes, f)
+			}
+		}
+	}
+
+	// Consider the fixes in the order of their first edit, so that the
+	// fixes that win over overlapping ones are deterministic.
+	sort.SliceStable(fixes, func(i, j int) bool {
+		a, b := fixes[i].edits[0], fixes[j].edits[0]
+		if a.path != b.path {
+			return a.path < b.path
+		}
+		if c := compareSpans(a.span, b.span); c != 0 {
+			return c < 0
+		}
+		if fixes[i].ruleID != fixes[j].ruleID {
+			return fixes[i].ruleID < fixes[j].ruleID
+		}
+		return a.newText < b.newText
+	})
+	return fixes
+}
+
+// compareSpans compares two spans by their start, then by their end.
+func compareSpans(a, b []int32) int {
+	ea := textEdit{span: a}
+	eb := textEdit{span: b}
+	aLine, aCol := ea.start()
+	bLine, bCol := eb.start()
+	if aLine != bLine {
+		return aLine - bLine
+	}
+	if aCol != bCol {
+		return aCol - bCol
+	}
+	aLine, aCol = ea.end()
+	bLine, bCol = eb.end()
+	if aLine != bLine {
+		return aLine - bLine
+	}
+	return aCol - bCol
+}
+
+// applyEdits applies non-overlapping edits to the source and returns the
+// result.
+func applyEdits(src []byte, edits []offsetEdit) []byte {
+	edits = append([]offsetEdit(nil), edits...)
+	sort.Slice(edits, func(i, j int) bool {
+		if edits[i].start != edits[j].start {
+			return edits[i].start < edits[j].start
+		}
+		return edits[i].end < edits[j].end
+	})
+	var buf bytes.Buffer
+	pos := 0
+	for _, e := range edits {
+		buf.Write(src[pos:e.start])
+		buf.WriteString(e.newText)
+		pos = e.end
+	}
+	buf.Write(src[pos:])
+	return buf.Bytes()
+}
+
+// lineOffsets returns the byte offset of the start of every line.
+func lineOffsets(src []byte) []int {
+	offsets := []int{0}
+	for i, b := range src {
+		if b == '\n' {
+			offsets = append(offsets, i+1)
+		}
+	}
+	return offsets
+}
+
+// byteOffset converts a zero-based line and column, as used in source code
+// locations, into a byte offset.
+//
+// Like protoc, the compiler counts columns in characters, with tabs advancing
+// to the next multiple of eight.
+func byteOffset(src []byte, lines []int, line, col int) (int, bool) {
+	if line < 0 || line >= len(lines) {
+		return 0, false
+	}
+	offset := lines[line]
+	for c := 0; c < col; {
+		if offset >= len(src) || src[offset] == '\n' {
+			return 0, false
+		}
+		r, size := utf8.DecodeRune(src[offset:])
+		if r == '\t' {
+			c += 8 - c%8
+		} else {
+			c++
+		}
+		offset += size
+	}
+	return offset, true
+}
@@ -0,0 +1,254 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/api-linter/v2/lint"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestApplyFixes(t *testing.T) {
+	edit := func(span []int32, newText string) textEdit {
+		return textEdit{path: "test.proto", span: span, newText: newText}
+	}
+	tests := []struct {
+		name  string
+		src   string
+		fixes []pendingFix
+		want  string
+	}{
+		{
+			name:  "Replace",
+			src:   "message Foo {\n  string badName = 1;\n}\n",
+			fixes: []pendingFix{{edits: []textEdit{edit([]int32{1, 9, 16}, "bad_name")}}},
+			want:  "message Foo {\n  string bad_name = 1;\n}\n",
+		},
+		{
+			name: "MultipleEdits",
+			src:  "a b c\nd e f\n",
+			fixes: []pendingFix{
+				{edits: []textEdit{edit([]int32{0, 0, 1}, "A"), edit([]int32{1, 2, 3}, "E")}},
+				{edits: []textEdit{edit([]int32{0, 4, 1, 1}, "CD")}},
+			},
+			want: "A b CD E f\n",
+		},
+		{
+			name: "InsertionBeforeReplacement",
+			src:  "message Foo {}\n",
+			fixes: []pendingFix{
+				{edits: []textEdit{edit([]int32{0, 8, 11}, "Bar")}},
+				{edits: []textEdit{edit([]int32{0, 0, 0}, "// Foo.\n")}},
+				{edits: []textEdit{edit([]int32{0, 8, 8}, "Baz")}},
+			},
+			want: "// Foo.\nmessage BazBar {}\n",
+		},
+		{
+			name: "OverlappingFixesFirstWins",
+			src:  "abcdef\n",
+			fixes: []pendingFix{
+				{edits: []textEdit{edit([]int32{0, 1, 4}, "X")}},
+				{edits: []textEdit{edit([]int32{0, 5, 6}, "F"), edit([]int32{0, 2, 5}, "Y")}},
+			},
+			want: "aXef\n",
+		},
+		{
+			name: "ConflictingInsertions",
+			src:  "abc\n",
+			fixes: []pendingFix{
+				{edits: []textEdit{edit([]int32{0, 1, 1}, "X")}},
+				{edits: []textEdit{edit([]int32{0, 1, 1}, "Y")}},
+			},
+			want: "aXbc\n",
+		},
+		{
+			name:  "Tabs",
+			src:   "\tstring badName = 1;\n",
+			fixes: []pendingFix{{edits: []textEdit{edit([]int32{0, 15, 22}, "bad_name")}}},
+			want:  "\tstring bad_name = 1;\n",
+		},
+		{
+			name:  "MultibyteCharacters",
+			src:   "// é\nfoo\n",
+			fixes: []pendingFix{{edits: []textEdit{edit([]int32{0, 3, 4}, "e")}}},
+			want:  "// e\nfoo\n",
+		},
+		{
+			name: "InvalidEdits",
+			src:  "abc\n",
+			fixes: []pendingFix{
+				{edits: []textEdit{edit([]int32{0, 0, 1}, "A"), edit([]int32{0, 2, 10}, "X")}},
+				{edits: []textEdit{edit([]int32{5, 0, 1}, "Y")}},
+				{edits: []textEdit{{path: "missing.proto", span: []int32{0, 0, 1}, newText: "Z"}}},
+			},
+			want: "abc\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "test.proto")
+			if err := writeFile(path, test.src); err != nil {
+				t.Fatal(err)
+			}
+			c := &cli{ProtoImportPaths: []string{dir}}
+			overlay := sourceOverlay{}
+			if _, err := c.applyFixes(test.fixes, overlay); err != nil {
+				t.Fatal(err)
+			}
+			got, err := overlay.read(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, string(got)); diff != "" {
+				t.Errorf("applyFixes() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFix(t *testing.T) {
+	proto := `syntax = "proto3";
+
+package test;
+
+message Book {
+  string name = 1;
+  string displayName = 2;
+  string coverImage = 3;
+}
+`
+	fixed := strings.NewReplacer("displayName", "display_name", "coverImage", "cover_image").Replace(proto)
+
+	for _, test := range []struct {
+		name     string
+		flag     string
+		wantFile string
+		wantOut  []string
+	}{
+		{"Fix", "--fix", fixed, nil},
+		{"DryRun", "--fix-dry-run", proto, []string{
+			"--- a/",
+			"-  string displayName = 2;\n",
+			"+  string display_name = 2;\n",
+			"+  string cover_image = 3;\n",
+		}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			protoPath := filepath.Join(dir, "test.proto")
+			if err := writeFile(protoPath, proto); err != nil {
+				t.Fatal(err)
+			}
+			outPath := filepath.Join(dir, "out")
+			err := runCLI([]string{
+				test.flag,
+				"--disable-rule=all",
+				"--enable-rule=core::0140::lower-snake",
+				"-I", dir,
+				"-o", outPath,
+				"test.proto",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := os.ReadFile(protoPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.wantFile, string(got)); diff != "" {
+				t.Errorf("file mismatch (-want +got):\n%s", diff)
+			}
+			out, err := os.ReadFile(outPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, want := range test.wantOut {
+				if !strings.Contains(string(out), want) {
+					t.Errorf("output does not contain %q:\n%s", want, out)
+				}
+			}
+			if test.wantOut == nil && strings.Contains(string(out), "lower-snake") {
+				t.Errorf("expected no problems to remain, got:\n%s", out)
+			}
+		})
+	}
+}
+
+func TestFixNotConverging(t *testing.T) {
+	dir := t.TempDir()
+	if err := writeFile(filepath.Join(dir, "test.proto"), "syntax = \"proto3\";\n"); err != nil {
+		t.Fatal(err)
+	}
+	// The fix of the rule always changes the file.
+	rules := lint.NewRuleRegistry()
+	err := rules.Register(111, &lint.FileRule{
+		Name: lint.NewRuleName(111, "never-fixed"),
+		LintFile: func(f protoreflect.FileDescriptor) []lint.Problem {
+			return []lint.Problem{{
+				Message:    "Never fixed.",
+				Descriptor: f,
+				Fixes: []lint.Fix{{
+					Title: "Add a comment",
+					Edits: []lint.TextEdit{{
+						FilePath: f.Path(),
+						Location: &dpb.SourceCodeInfo_Location{Span: []int32{0, 0, 0}},
+						NewText:  "// Comment.\n",
+					}},
+				}},
+			}}
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stderr, err := os.CreateTemp(t.TempDir(), "stderr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func(f *os.File) { os.Stderr = f }(os.Stderr)
+	os.Stderr = stderr
+
+	c := &cli{ProtoImportPaths: []string{dir}, ProtoFiles: []string{"test.proto"}}
+	results, overlay, err := c.fix(rules, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || len(results[0].Problems) != 1 {
+		t.Errorf("Got results %v, want the problem that was not fixed", results)
+	}
+	src, err := overlay.read(filepath.Join(dir, "test.proto"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Count(string(src), "// Comment.\n"); got != maxFixPasses {
+		t.Errorf("Got %d comments, want %d", got, maxFixPasses)
+	}
+	out, err := os.ReadFile(stderr.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "warning: fixes were still pending after 10 passes, and were not applied: core::0111::never-fixed\n"; string(out) != want {
+		t.Errorf("Got stderr %q, want %q", out, want)
+	}
+}
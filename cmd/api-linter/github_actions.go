@@ -31,7 +31,7 @@ func formatGitHubActionOutput(responses []lint.Response) []byte {
 			// ::error file={name},line={line},endLine={endLine},title={title}::{message}
 			// https://docs.github.com/en/actions/using-workflows/workflow-commands-for-github-actions#setting-an-error-message
 
-			fmt.Fprintf(&buf, "::error file=%s", response.FilePath)
+			fmt.Fprintf(&buf, "::%s file=%s", githubCommand(problem.Severity), response.FilePath)
 			if problem.Location != nil {
 				// Some findings are *line level* and only have start positions but no
 				// starting column. Construct a switch fallthrough to emit as many of
@@ -67,3 +67,15 @@ func formatGitHubActionOutput(responses []lint.Response) []byte {
 
 	return buf.Bytes()
 }
+
+// githubCommand returns the workflow command that matches a problem severity.
+func githubCommand(s lint.Severity) string {
+	switch s {
+	case lint.SeverityWarning:
+		return "warning"
+	case lint.SeverityInfo:
+		return "notice"
+	default:
+		return "error"
+	}
+}
@@ -100,6 +100,23 @@ func TestFormatGitHubActionOutput(t *testing.T) {
 			},
 			want: `::error file=example.proto,endColumn=4,endLine=3,col=2,line=1,title=core։։naming_formats։։field_names::\n\nhttps://linter.aip.dev/naming_formats/field_names
 ::error file=example.proto,endColumn=8,endLine=7,col=6,line=5,title=core։։naming_formats։։field_names::multi\nline\ncomment\n\nhttps://linter.aip.dev/naming_formats/field_names
+`,
+		},
+		{
+			name: "Example with severities",
+			data: []lint.Response{
+				{
+					FilePath: "example.proto",
+					Problems: []lint.Problem{
+						{RuleID: "a", Message: "error", Severity: lint.SeverityError},
+						{RuleID: "b", Message: "warning", Severity: lint.SeverityWarning},
+						{RuleID: "c", Message: "info", Severity: lint.SeverityInfo},
+					},
+				},
+			},
+			want: `::error file=example.proto,title=a::error
+::warning file=example.proto,title=b::warning
+::notice file=example.proto,title=c::info
 `,
 		},
 		{
@@ -191,6 +191,190 @@ func TestRules_DisabledByConfig(t *testing.T) {
 	}
 }
 
+func TestReportUnusedDisables(t *testing.T) {
+	proto := `
+		// (-- api-linter: core::9999::no-such-rule=disabled --)
+		syntax = "proto3";
+		package test;
+		message Test {
+			// (-- api-linter: core::0140::lower-snake=disabled --)
+			string badName = 1;
+			// (-- api-linter: core::0140::lower-snake=disabled --)
+			string good_name = 2;
+			// (-- api-linter: core::0140=disabled --)
+			string other_name = 3;
+			// (-- api-linter: core::0131::request-message-name=disabled --)
+			string last_name = 4;
+		}
+	`
+	config := `[ { "disabled_rules": [ "core::0131" ] } ]`
+	_, result := runLinterWithFailureStatus(t, proto, config, []string{"--report-unused-disables"})
+	for _, want := range []string{
+		`names the unknown rule "core::9999::no-such-rule"`,
+		`Disable comment for "core::0140::lower-snake" on "test.Test.good_name" did not suppress any problem.`,
+		`Disable comment for "core::0140" on "test.Test.other_name" did not suppress any problem.`,
+	} {
+		if !strings.Contains(result, want) {
+			t.Errorf("Expected %q in the output, got:\n%s", want, result)
+		}
+	}
+	for _, unwanted := range []string{"test.Test.badName", "core::0131"} {
+		if strings.Contains(result, unwanted) {
+			t.Errorf("Unexpected %q in the output, got:\n%s", unwanted, result)
+		}
+	}
+
+	// The comments are not reported without the flag.
+	if result := runLinter(t, proto, config); strings.Contains(result, "api-linter::") {
+		t.Errorf("Unexpected problems about disable comments, got:\n%s", result)
+	}
+}
+
+func TestDisableReasonAndExpiry(t *testing.T) {
+	proto := `
+		syntax = "proto3";
+		package test;
+		message Test {
+			// (-- api-linter: core::0140::lower-snake=disabled reason="legacy field" until=2999-01-01 --)
+			string firstName = 1;
+			// (-- api-linter: core::0140::lower-snake=disabled reason="legacy field" until=2000-01-01 --)
+			string secondName = 2;
+			// (-- api-linter: core::0140::lower-snake=disabled --)
+			string thirdName = 3;
+		}
+	`
+	for _, test := range []struct {
+		name     string
+		config   string
+		want     []string
+		unwanted []string
+	}{
+		{
+			name: "ReasonNotRequired",
+			want: []string{
+				`Disable comment for "core::0140::lower-snake" on "test.Test.secondName" expired on 2000-01-01.`,
+				"Field `secondName` must use lower_snake_case.",
+			},
+			unwanted: []string{"Field `firstName`", "Field `thirdName`", "api-linter::invalid-disable"},
+		},
+		{
+			name:   "ReasonRequired",
+			config: `[ { "require_disable_reason": true } ]`,
+			want: []string{
+				`Disable comment for "core::0140::lower-snake" on "test.Test.thirdName" has no reason, which the config requires.`,
+				"Field `thirdName` must use lower_snake_case.",
+			},
+			unwanted: []string{"Field `firstName`"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			result := runLinter(t, proto, test.config)
+			for _, want := range test.want {
+				if !strings.Contains(result, want) {
+					t.Errorf("Expected %q in the output, got:\n%s", want, result)
+				}
+			}
+			for _, unwanted := range test.unwanted {
+				if strings.Contains(result, unwanted) {
+					t.Errorf("Unexpected %q in the output, got:\n%s", unwanted, result)
+				}
+			}
+		})
+	}
+}
+
+func TestCustomRules(t *testing.T) {
+	config := `
+	[
+		{
+			"disabled_rules": ["core"],
+			"custom_rules": [
+				{
+					"name": "request-owner",
+					"kind": "message",
+					"only_if": {"name": "Request$"},
+					"assert": {"option": "(test.v1.owner)"},
+					"message": "Request messages must set (test.v1.owner)."
+				},
+				{
+					"name": "timestamp-suffix",
+					"kind": "field",
+					"only_if": {"type": "google.protobuf.Timestamp"},
+					"assert": {"name": "_time$"},
+					"message": "Timestamp fields must end in _time."
+				},
+				{
+					"name": "field-count",
+					"kind": "message",
+					"only_if": {"cel": "full_name == 'test.v1.Book'"},
+					"assert": {"cel": "size(descriptor.field) < 2"},
+					"message": "Book must have fewer than two fields."
+				}
+			]
+		}
+	]
+	`
+	proto := `
+	syntax = "proto3";
+
+	package test.v1;
+
+	import "google/protobuf/descriptor.proto";
+	import "google/protobuf/timestamp.proto";
+
+	extend google.protobuf.MessageOptions {
+		string owner = 50000;
+	}
+
+	message GetBookRequest {}
+
+	message ListBooksRequest {
+		option (owner) = "books-team";
+	}
+
+	// (-- api-linter: custom::request-owner=disabled --)
+	message DeleteBookRequest {}
+
+	message Book {
+		google.protobuf.Timestamp published = 1;
+		google.protobuf.Timestamp update_time = 2;
+	}
+	`
+	result := runLinter(t, proto, config)
+	for _, want := range []string{
+		"Request messages must set (test.v1.owner).",
+		"Timestamp fields must end in _time.",
+		"Book must have fewer than two fields.",
+	} {
+		if got := strings.Count(result, want); got != 1 {
+			t.Errorf("Got %d problems %q, want 1:\n%s", got, want, result)
+		}
+	}
+
+	// Invalid custom rules are an error.
+	config = `[{"custom_rules": [{"name": "cel", "kind": "message", "assert": {"cel": "name"}, "message": "m"}]}]`
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.json")
+	if err := writeFile(configPath, config); err != nil {
+		t.Fatal(err)
+	}
+	err := runCLI([]string{"--config=" + configPath, "--list-rules"})
+	if err == nil || !strings.Contains(err.Error(), `custom rule "cel": assert: invalid cel`) {
+		t.Errorf("Got error %v, want an error for the CEL expression", err)
+	}
+
+	// The fields of the config are checked before its custom rules are built
+	// and the plugins are started.
+	config = `[{"custom_rules": [{"name": "file", "kind": "file", "asert": {"name": "^a"}, "message": "m"}]}]`
+	if err := writeFile(configPath, config); err != nil {
+		t.Fatal(err)
+	}
+	err = runCLI([]string{"--config=" + configPath, "--plugin=" + filepath.Join(dir, "missing"), "--list-rules"})
+	if err == nil || !strings.Contains(err.Error(), `unknown field "asert", did you mean "assert"?`) {
+		t.Errorf("Got error %v, want an error for the field", err)
+	}
+}
+
 func TestBuildErrors(t *testing.T) {
 	expected := []string{
 		"internal/testdata/build_errors.proto:8:1:",
@@ -213,6 +397,67 @@ func TestBuildErrors(t *testing.T) {
 	}
 }
 
+func TestCompileErrorsAsProblems(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"good.proto":   "syntax = \"proto3\";\npackage test;\nmessage Good { string badName = 1; }\n",
+		"broken.proto": "syntax = \"proto3\";\npackage test;\nmessage Broken { Missing m = 1; }\n",
+	})
+	out := filepath.Join(root, "out.yaml")
+	err := runCLI([]string{"-I", root, "-o", out, "good.proto", "broken.proto"})
+	if err == nil || !strings.Contains(err.Error(), "broken.proto:3:18:") {
+		t.Fatalf("Got error %v, want the compile error of broken.proto.", err)
+	}
+	b, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"file_path: broken.proto",
+		"rule_id: compile-error",
+		"line_number: 3",
+		"column_number: 18",
+		"file_path: good.proto",
+		"rule_id: core::0140::lower-snake",
+	} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("Expected %q in the output, got:\n%s", want, b)
+		}
+	}
+}
+
+func TestCompileErrorsOfImports(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"good.proto":   "syntax = \"proto3\";\npackage test;\nmessage Good {}\n",
+		"broken.proto": "syntax = \"proto3\";\npackage test;\nmessage Broken { Missing m = 1; }\n",
+		"user.proto":   "syntax = \"proto3\";\npackage test;\n\nimport \"broken.proto\";\n\nmessage User { Broken b = 1; }\n",
+	})
+	for _, files := range [][]string{
+		{"good.proto", "user.proto"},
+		{"good.proto", "broken.proto", "user.proto"},
+		{"user.proto"},
+	} {
+		err := runCLI(append([]string{"-I", root}, files...))
+		want := "user.proto:4:1: imports files that failed to compile: broken.proto"
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%v: got error %v, want it to contain %q.", files, err, want)
+		}
+	}
+}
+
+func TestInvalidConfig(t *testing.T) {
+	config := filepath.Join(t.TempDir(), "config.yaml")
+	if err := writeFile(config, "- disabled_rules: ['core::0131::http-methd']\n"); err != nil {
+		t.Fatal(err)
+	}
+	err := runCLI([]string{"--config=" + config, "internal/testdata/dummy.proto"})
+	want := config + `:1:20: "core::0131::http-methd" does not match any rule, did you mean "core::0131::http-method"?`
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Fatalf("Got error %v, want it to contain %q.", err, want)
+	}
+}
+
 func TestMultipleFilesFromParentDir(t *testing.T) {
 	// This test addresses a previously found bug:
 	// https://github.com/googleapis/api-linter/v2/issues/1465
@@ -277,6 +522,63 @@ func TestMultipleFilesFromParentDir(t *testing.T) {
 	}
 }
 
+func TestLintDirectory(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"protos/api/a.proto":        "syntax = \"proto3\";\npackage api;\nimport \"api/b.proto\";\nmessage A { B b = 1; }\n",
+		"protos/api/b.proto":        "syntax = \"proto3\";\npackage api;\nmessage B {}\n",
+		"protos/api/vendor/c.proto": "syntax = \"proto3\";\npackage vendor;\nmessage C {}\n",
+		"config.yaml":               "- ignored_paths: ['api/vendor/**']\n",
+	})
+	out := filepath.Join(root, "out.yaml")
+	args := []string{"-I", filepath.Join(root, "protos"), "--config", filepath.Join(root, "config.yaml"), "-o", out, "api"}
+	if err := runCLI(args); err != nil {
+		t.Fatal(err)
+	}
+	b, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"file_path: api/a.proto", "file_path: api/b.proto"} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("Expected %q in the output, got:\n%s", want, b)
+		}
+	}
+	if strings.Contains(string(b), "c.proto") {
+		t.Errorf("Expected the ignored file to be left out, got:\n%s", b)
+	}
+}
+
+func TestBufWorkspace(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"buf.yaml":                 "version: v2\nmodules:\n  - path: proto\n    excludes: [proto/api/legacy]\n  - path: common\nlint:\n  ignore_only:\n    core::0140::lower-snake: [proto/api/b.proto]\n",
+		"common/common/c.proto":    "syntax = \"proto3\";\npackage common;\nmessage C {}\n",
+		"proto/api/a.proto":        "syntax = \"proto3\";\npackage api;\nimport \"common/c.proto\";\nmessage A { common.C aField = 1; }\n",
+		"proto/api/b.proto":        "syntax = \"proto3\";\npackage api;\nmessage B { string bField = 1; }\n",
+		"proto/api/legacy/d.proto": "syntax = \"proto3\";\npackage api.legacy;\nmessage D {}\n",
+	})
+	out := filepath.Join(root, "out.yaml")
+	args := []string{"--buf-workspace", root, "-o", out, "api"}
+	if err := runCLI(args); err != nil {
+		t.Fatal(err)
+	}
+	b, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"file_path: api/a.proto", "file_path: api/b.proto", "Field `aField` must use lower_snake_case."} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("Expected %q in the output, got:\n%s", want, b)
+		}
+	}
+	for _, notWant := range []string{"d.proto", "Field `bField` must use lower_snake_case."} {
+		if strings.Contains(string(b), notWant) {
+			t.Errorf("Expected no %q in the output, got:\n%s", notWant, b)
+		}
+	}
+}
+
 func TestImportFromAnotherRoot(t *testing.T) {
 	// This test case is based on a scenario described in:
 	// https://github.com/googleapis/api-linter/pull/1519
@@ -416,6 +718,22 @@ func TestExitStatusForLintFailure(t *testing.T) {
 		}
 	})
 
+	// checks lint failure = false when only problems below the severity threshold are found
+	t.Run(failCase.testName+"BelowSeverityReturnsNoFailure", func(t *testing.T) {
+		warnOnly := `[ { "disabled_rules": [ "all" ], "enabled_rules": [ "core::0131" ], "rule_severities": { "core::0131": "warning" } } ]`
+		lintFailureStatus, result := runLinterWithFailureStatus(t, failCase.proto, warnOnly, []string{"--set-exit-status", "--exit-status-severity=error"})
+		if !strings.Contains(result, "severity: warning") {
+			t.Fatalf("Expected warnings in the output, got:\n%s", result)
+		}
+		if lintFailureStatus {
+			t.Fatalf("Expected: %v Actual: %v", false, lintFailureStatus)
+		}
+		lintFailureStatus, _ = runLinterWithFailureStatus(t, failCase.proto, warnOnly, []string{"--set-exit-status", "--exit-status-severity=warning"})
+		if !lintFailureStatus {
+			t.Fatalf("Expected: %v Actual: %v", true, lintFailureStatus)
+		}
+	})
+
 	// checks lint failure = false when lint problems found but --set-exit-status not set
 	for _, test := range testCases {
 		t.Run(test.testName, func(t *testing.T) {
@@ -429,6 +747,47 @@ func TestExitStatusForLintFailure(t *testing.T) {
 	}
 }
 
+func TestBaseline(t *testing.T) {
+	baselinePath := filepath.Join(t.TempDir(), "baseline.yaml")
+	proto := `
+		syntax = "proto3";
+
+		service Library {
+			rpc GetBook(Book) returns (Book);
+		}
+
+		message Book {}
+	`
+
+	// Writing a baseline suppresses every existing problem.
+	failure, result := runLinterWithFailureStatus(t, proto, "", []string{"--set-exit-status", "--write-baseline", baselinePath})
+	if failure {
+		t.Fatalf("Expected no failure after writing a baseline, got:\n%s", result)
+	}
+
+	// The baseline keeps suppressing the problems, even when they move.
+	failure, result = runLinterWithFailureStatus(t, "\n\n"+proto, "", []string{"--set-exit-status", "--baseline", baselinePath})
+	if failure {
+		t.Fatalf("Expected no failure with a baseline, got:\n%s", result)
+	}
+
+	// New problems are still reported.
+	newProblem := strings.ReplaceAll(proto, "message Book {}", "message Book {}\nmessage Bad { string badName = 1; }")
+	failure, result = runLinterWithFailureStatus(t, newProblem, "", []string{"--set-exit-status", "--baseline", baselinePath})
+	if !failure || !strings.Contains(result, "core::0140::lower-snake") {
+		t.Fatalf("Expected the new problem to be reported, got:\n%s", result)
+	}
+	if strings.Contains(result, "core::0131::request-message-name") {
+		t.Errorf("Expected the known problem to be suppressed, got:\n%s", result)
+	}
+
+	// A baseline can not be read and written at once.
+	err := runCLI([]string{"--baseline", baselinePath, "--write-baseline", baselinePath, "internal/testdata/dummy.proto"})
+	if err == nil || !strings.Contains(err.Error(), "can not be used together") {
+		t.Errorf("Got error %v, want --baseline and --write-baseline to be rejected.", err)
+	}
+}
+
 func runLinter(t *testing.T, protoContent, configContent string) string {
 	_, result := runLinterWithFailureStatus(t, protoContent, configContent, []string{})
 	return result
@@ -0,0 +1,549 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/googleapis/api-linter/v2/internal"
+	"github.com/googleapis/api-linter/v2/lint"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// lspServer is a language server that lints the open proto files, so that
+// editors can show problems while they are being written.
+//
+// The open files are compiled with the same options as the command line,
+// using the contents of the editor buffers instead of the files on disk.
+//
+// The configs are discovered next to each document, unless a config file is
+// given with --config, and the rules they define are loaded with them.
+type lspServer struct {
+	cli     *cli
+	rules   lint.RuleRegistry
+	configs lint.Configs
+	conn    *jsonrpcConn
+
+	// The open documents, keyed by URI.
+	docs     map[string]*lspDocument
+	shutdown bool
+
+	// The rules and configs of the documents, keyed by their paths.
+	loaded map[string]*lspRules
+}
+
+// lspRules are the rules and configs of a document, along with the states of
+// the config files they were loaded from.
+type lspRules struct {
+	stamps  fileStamps
+	rules   lint.RuleRegistry
+	configs lint.Configs
+}
+
+// lspDocument is a file open in the editor.
+type lspDocument struct {
+	path    string
+	version int
+	text    string
+
+	// The problems found the last time the document was linted, along with
+	// the diagnostics that were published for them.
+	problems []lspProblem
+}
+
+type lspProblem struct {
+	problem    lint.Problem
+	diagnostic lspDiagnostic
+}
+
+// serveLSP runs a language server that reads requests from r and writes
+// responses to w, until the client asks it to exit.
+func (c *cli) serveLSP(r io.Reader, w io.Writer, rules lint.RuleRegistry, configs lint.Configs) error {
+	configs, err := c.useBufWorkspace(configs)
+	if err != nil {
+		return err
+	}
+	s := &lspServer{
+		cli:     c,
+		rules:   rules,
+		configs: configs,
+		conn:    newJSONRPCConn(r, w),
+		docs:    map[string]*lspDocument{},
+		loaded:  map[string]*lspRules{},
+	}
+	return s.serve()
+}
+
+// errLSPExit signals that the client asked the server to exit.
+var errLSPExit = errors.New("exit")
+
+func (s *lspServer) serve() error {
+	for {
+		msg, err := s.conn.read()
+		if err == io.EOF {
+			return nil
+		}
+		var rpcErr *jsonrpcError
+		if errors.As(err, &rpcErr) {
+			if err := s.conn.reply(nil, nil, rpcErr); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		err = s.handle(msg)
+		if err == errLSPExit {
+			if !s.shutdown {
+				return errors.New("the language server exited before it was shut down")
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// handle processes a single request or notification.
+func (s *lspServer) handle(msg *jsonrpcMessage) error {
+	// Requests expect a response, notifications do not.
+	isRequest := msg.ID != nil
+	params := func(v interface{}) bool {
+		if err := json.Unmarshal(msg.Params, v); err != nil {
+			if isRequest {
+				_ = s.conn.reply(msg.ID, nil, &jsonrpcError{Code: jsonrpcInvalidParams, Message: err.Error()})
+			}
+			return false
+		}
+		return true
+	}
+
+	switch msg.Method {
+	case "initialize":
+		return s.conn.reply(msg.ID, lspInitializeResult{
+			Capabilities: lspServerCapabilities{
+				TextDocumentSync: lspTextDocumentSyncOptions{
+					OpenClose: true,
+					Change:    lspTextDocumentSyncFull,
+					Save:      lspSaveOptions{IncludeText: true},
+				},
+				CodeActionProvider: lspCodeActionOptions{CodeActionKinds: []string{"quickfix"}},
+			},
+			ServerInfo: lspServerInfo{Name: "api-linter", Version: internal.Version},
+		}, nil)
+	case "initialized":
+		return nil
+	case "shutdown":
+		s.shutdown = true
+		return s.conn.reply(msg.ID, nil, nil)
+	case "exit":
+		return errLSPExit
+	case "textDocument/didOpen":
+		var p lspDidOpenParams
+		if !params(&p) {
+			return nil
+		}
+		path, err := uriToPath(p.TextDocument.URI)
+		if err != nil {
+			return nil
+		}
+		s.docs[p.TextDocument.URI] = &lspDocument{path: path, version: p.TextDocument.Version, text: p.TextDocument.Text}
+		return s.lintDocument(p.TextDocument.URI)
+	case "textDocument/didChange":
+		var p lspDidChangeParams
+		if !params(&p) {
+			return nil
+		}
+		doc, ok := s.docs[p.TextDocument.URI]
+		if !ok || len(p.ContentChanges) == 0 {
+			return nil
+		}
+		// The server asks for full syncs, so the last change holds the whole
+		// document.
+		doc.version = p.TextDocument.Version
+		doc.text = p.ContentChanges[len(p.ContentChanges)-1].Text
+		return s.lintDocument(p.TextDocument.URI)
+	case "textDocument/didSave":
+		var p lspDidSaveParams
+		if !params(&p) {
+			return nil
+		}
+		if doc, ok := s.docs[p.TextDocument.URI]; ok && p.Text != nil {
+			doc.text = *p.Text
+		}
+		// Other open documents may import the saved one.
+		for _, uri := range sortedKeys(s.docs) {
+			if err := s.lintDocument(uri); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "textDocument/didClose":
+		var p lspDidCloseParams
+		if !params(&p) {
+			return nil
+		}
+		if doc, ok := s.docs[p.TextDocument.URI]; ok {
+			delete(s.loaded, doc.path)
+		}
+		delete(s.docs, p.TextDocument.URI)
+		return s.conn.notify("textDocument/publishDiagnostics", lspPublishDiagnosticsParams{
+			URI:         p.TextDocument.URI,
+			Diagnostics: []lspDiagnostic{},
+		})
+	case "textDocument/codeAction":
+		var p lspCodeActionParams
+		if !params(&p) {
+			return nil
+		}
+		return s.conn.reply(msg.ID, s.codeActions(p), nil)
+	}
+
+	if isRequest {
+		return s.conn.reply(msg.ID, nil, &jsonrpcError{
+			Code:    jsonrpcMethodNotFound,
+			Message: fmt.Sprintf("method %q is not supported", msg.Method),
+		})
+	}
+	return nil
+}
+
+// lintDocument lints an open document and publishes its problems as
+// diagnostics.
+func (s *lspServer) lintDocument(uri string) error {
+	doc := s.docs[uri]
+	c, name := s.fileCLI(doc.path)
+	src := []byte(doc.text)
+
+	doc.problems = nil
+	diagnostics := []lspDiagnostic{}
+	var results []lint.Response
+	loaded, err := s.loadRules(c, doc.path)
+	if err == nil {
+		results, err = c.lintFiles(loaded.rules, loaded.configs, s.overlay(c), nil)
+	}
+	if err != nil {
+		diagnostics = append(diagnostics, compileErrorDiagnostics(err, name, src)...)
+	}
+	for _, r := range results {
+		for _, p := range r.Problems {
+			d := problemDiagnostic(src, p)
+			doc.problems = append(doc.problems, lspProblem{problem: p, diagnostic: d})
+			diagnostics = append(diagnostics, d)
+		}
+	}
+
+	version := doc.version
+	return s.conn.notify("textDocument/publishDiagnostics", lspPublishDiagnosticsParams{
+		URI:         uri,
+		Version:     &version,
+		Diagnostics: diagnostics,
+	})
+}
+
+// loadRules returns the rules and configs of the document at the given path,
+// given the command line options that compile it. They are loaded again when
+// its config files change.
+func (s *lspServer) loadRules(c *cli, path string) (*lspRules, error) {
+	paths, err := c.configFiles()
+	if err != nil {
+		return nil, err
+	}
+	stamps := fileStamps{}
+	for _, p := range paths {
+		stamps.add(p)
+	}
+	if loaded, ok := s.loaded[path]; ok && len(stamps.changed(loaded.stamps)) == 0 {
+		return loaded, nil
+	}
+	rules, configs, err := c.loadRules(s.rules, s.configs)
+	if err != nil {
+		delete(s.loaded, path)
+		return nil, err
+	}
+	loaded := &lspRules{stamps: stamps, rules: rules, configs: configs}
+	s.loaded[path] = loaded
+	return loaded, nil
+}
+
+// fileCLI returns a copy of the command line options that compiles the file
+// at the given path, along with the name of the file relative to its import
+// path.
+//
+// The name is relative to the most specific import path that contains the
+// file. If there is none, the directory of the file is used as an
+// additional import path.
+func (s *lspServer) fileCLI(path string) (*cli, string) {
+	c := *s.cli
+	c.SkipCompilationFlag = false
+
+	var root, name string
+	for _, importPath := range resolveImports(c.ProtoImportPaths) {
+		abs, rel, ok := relativeToImportPath(importPath, path)
+		if ok && len(abs) > len(root) {
+			root, name = abs, filepath.ToSlash(rel)
+		}
+	}
+	if name == "" {
+		c.ProtoImportPaths = append(append([]string(nil), c.ProtoImportPaths...), filepath.Dir(path))
+		name = filepath.Base(path)
+	}
+	c.ProtoFiles = []string{name}
+	return &c, name
+}
+
+// overlay returns the contents of the open documents, keyed by every path
+// the compiler may use to open them.
+func (s *lspServer) overlay(c *cli) sourceOverlay {
+	overlay := sourceOverlay{}
+	for _, doc := range s.docs {
+		for _, importPath := range resolveImports(c.ProtoImportPaths) {
+			if _, rel, ok := relativeToImportPath(importPath, doc.path); ok {
+				overlay[filepath.Clean(filepath.Join(importPath, rel))] = []byte(doc.text)
+			}
+		}
+	}
+	return overlay
+}
+
+// relativeToImportPath returns the absolute import path and the path of the
+// file relative to it, if the file is inside the import path.
+func relativeToImportPath(importPath, path string) (string, string, bool) {
+	abs, err := filepath.Abs(importPath)
+	if err != nil {
+		return "", "", false
+	}
+	rel, err := filepath.Rel(abs, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", "", false
+	}
+	return abs, rel, true
+}
+
+// codeActions returns the quick fixes for the diagnostics of a code action
+// request: the fixes of the problem, and a comment that disables its rule.
+func (s *lspServer) codeActions(p lspCodeActionParams) []lspCodeAction {
+	actions := []lspCodeAction{}
+	doc, ok := s.docs[p.TextDocument.URI]
+	if !ok {
+		return actions
+	}
+	c, _ := s.fileCLI(doc.path)
+	overlay := s.overlay(c)
+
+	for _, d := range p.Context.Diagnostics {
+		for _, lp := range doc.problems {
+			if lp.diagnostic.Range != d.Range || lp.diagnostic.Code != d.Code || lp.diagnostic.Message != d.Message {
+				continue
+			}
+			for i, f := range lp.problem.GetFixes() {
+				edit, ok := c.lspWorkspaceEdit(f.Edits, overlay)
+				if !ok {
+					continue
+				}
+				actions = append(actions, lspCodeAction{
+					Title:       f.Title,
+					Kind:        "quickfix",
+					Diagnostics: []lspDiagnostic{lp.diagnostic},
+					IsPreferred: i == 0,
+					Edit:        edit,
+				})
+			}
+			actions = append(actions, disableRuleAction(p.TextDocument.URI, []byte(doc.text), lp))
+		}
+	}
+	return actions
+}
+
+// lspWorkspaceEdit converts the edits of a fix, which may span several
+// files. It returns false if any of the files can not be found.
+func (c *cli) lspWorkspaceEdit(edits []lint.TextEdit, overlay sourceOverlay) (lspWorkspaceEdit, bool) {
+	we := lspWorkspaceEdit{Changes: map[string][]lspTextEdit{}}
+	for _, e := range edits {
+		span := e.Location.GetSpan()
+		if len(span) != 3 && len(span) != 4 {
+			return we, false
+		}
+		diskPath, ok := c.sourcePath(e.FilePath, overlay)
+		if !ok {
+			return we, false
+		}
+		src, err := overlay.read(diskPath)
+		if err != nil {
+			return we, false
+		}
+		abs, err := filepath.Abs(diskPath)
+		if err != nil {
+			return we, false
+		}
+		uri := pathToURI(abs)
+		we.Changes[uri] = append(we.Changes[uri], lspTextEdit{
+			Range:   lspRangeFromSpan(src, span),
+			NewText: e.NewText,
+		})
+	}
+	return we, true
+}
+
+// disableRuleAction returns an action that disables the rule of a problem
+// with a comment on its descriptor, or on the file for file-level problems.
+func disableRuleAction(uri string, src []byte, lp lspProblem) lspCodeAction {
+	comment := fmt.Sprintf("// (-- api-linter: %s=disabled --)\n", lp.problem.RuleID)
+	title := fmt.Sprintf("Disable %s for this file", lp.problem.RuleID)
+
+	var pos lspPosition
+	d := lp.problem.Descriptor
+	if _, isFile := d.(protoreflect.FileDescriptor); d != nil && !isFile {
+		// Insert the comment on the line before the descriptor, with the
+		// same indentation, so that it becomes part of its leading comments.
+		line := d.ParentFile().SourceLocations().ByDescriptor(d).StartLine
+		lines := lineOffsets(src)
+		if line < len(lines) {
+			rest := src[lines[line]:]
+			indent := rest[:len(rest)-len(strings.TrimLeft(string(rest), " \t"))]
+			comment = string(indent) + comment
+			pos = lspPosition{Line: line}
+			title = fmt.Sprintf("Disable %s for %s", lp.problem.RuleID, d.Name())
+		}
+	}
+
+	return lspCodeAction{
+		Title:       title,
+		Kind:        "quickfix",
+		Diagnostics: []lspDiagnostic{lp.diagnostic},
+		Edit: lspWorkspaceEdit{Changes: map[string][]lspTextEdit{
+			uri: {{Range: lspRange{Start: pos, End: pos}, NewText: comment}},
+		}},
+	}
+}
+
+// problemDiagnostic converts a problem into a diagnostic.
+func problemDiagnostic(src []byte, p lint.Problem) lspDiagnostic {
+	d := lspDiagnostic{
+		Severity: lspSeverity(p.Severity),
+		Code:     string(p.RuleID),
+		Source:   "api-linter",
+		Message:  p.Message,
+	}
+	if span := problemSpan(p); len(span) == 3 || len(span) == 4 {
+		d.Range = lspRangeFromSpan(src, span)
+	}
+	if uri := p.GetRuleURI(); uri != "" {
+		d.CodeDescription = &lspCodeDescription{Href: uri}
+	}
+	return d
+}
+
+// lspSeverity returns the diagnostic severity that matches a problem
+// severity.
+func lspSeverity(s lint.Severity) int {
+	switch s {
+	case lint.SeverityWarning:
+		return lspSeverityWarning
+	case lint.SeverityInfo:
+		return lspSeverityInformation
+	default:
+		return lspSeverityError
+	}
+}
+
+// compileErrorDiagnostics converts the errors of a run into diagnostics.
+// Compile errors in the named file are placed at their position, and other
+// errors (for example, in an imported file) at the start of the file.
+func compileErrorDiagnostics(err error, name string, src []byte) []lspDiagnostic {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var diagnostics []lspDiagnostic
+		for _, e := range joined.Unwrap() {
+			diagnostics = append(diagnostics, compileErrorDiagnostics(e, name, src)...)
+		}
+		return diagnostics
+	}
+	var cerr *compileError
+	if !errors.As(err, &cerr) {
+		return []lspDiagnostic{{Severity: lspSeverityError, Source: "api-linter", Message: err.Error()}}
+	}
+	var diagnostics []lspDiagnostic
+	lines := lineOffsets(src)
+	for _, e := range cerr.errs {
+		d := lspDiagnostic{Severity: lspSeverityError, Source: "api-linter", Message: e.Error()}
+		if p := e.GetPosition(); filepath.ToSlash(p.Filename) == name {
+			pos := lspPositionAt(src, lines, max(p.Line-1, 0), max(p.Col-1, 0))
+			d.Range = lspRange{Start: pos, End: pos}
+			d.Message = e.Unwrap().Error()
+		}
+		diagnostics = append(diagnostics, d)
+	}
+	return diagnostics
+}
+
+// lspRangeFromSpan converts a source code info span into a range.
+func lspRangeFromSpan(src []byte, span []int32) lspRange {
+	e := textEdit{span: span}
+	lines := lineOffsets(src)
+	startLine, startCol := e.start()
+	endLine, endCol := e.end()
+	return lspRange{
+		Start: lspPositionAt(src, lines, startLine, startCol),
+		End:   lspPositionAt(src, lines, endLine, endCol),
+	}
+}
+
+// lspPositionAt converts a zero-based line and column, as used in source
+// code locations, into a position. Columns are counted in characters with
+// tabs expanded, but positions count UTF-16 code units.
+func lspPositionAt(src []byte, lines []int, line, col int) lspPosition {
+	offset, ok := byteOffset(src, lines, line, col)
+	if !ok {
+		return lspPosition{Line: line, Character: col}
+	}
+	return lspPosition{Line: line, Character: len(utf16.Encode([]rune(string(src[lines[line]:offset]))))}
+}
+
+// uriToPath returns the path of a file URI.
+func uriToPath(uri string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "file" {
+		return "", fmt.Errorf("unsupported URI %q", uri)
+	}
+	return filepath.FromSlash(u.Path), nil
+}
+
+// pathToURI returns the file URI of an absolute path.
+func pathToURI(path string) string {
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String()
+}
+
+func sortedKeys(docs map[string]*lspDocument) []string {
+	keys := make([]string, 0, len(docs))
+	for k := range docs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
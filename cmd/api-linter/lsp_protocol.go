@@ -0,0 +1,265 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// The JSON-RPC 2.0 messages exchanged with the client, framed with the
+// base protocol of the Language Server Protocol (a Content-Length header
+// followed by the JSON content).
+// See https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/.
+
+// JSON-RPC error codes.
+const (
+	jsonrpcParseError     = -32700
+	jsonrpcMethodNotFound = -32601
+	jsonrpcInvalidParams  = -32602
+)
+
+// jsonrpcMessage is a request, a response or a notification. Requests have
+// an ID and a method, notifications only have a method, and responses only
+// have an ID.
+type jsonrpcMessage struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id,omitempty"`
+	Method  string           `json:"method,omitempty"`
+	Params  json.RawMessage  `json:"params,omitempty"`
+	Result  json.RawMessage  `json:"result,omitempty"`
+	Error   *jsonrpcError    `json:"error,omitempty"`
+}
+
+type jsonrpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *jsonrpcError) Error() string {
+	return fmt.Sprintf("jsonrpc error %d: %s", e.Code, e.Message)
+}
+
+// jsonrpcConn reads and writes framed JSON-RPC messages.
+type jsonrpcConn struct {
+	r *bufio.Reader
+
+	// mu serializes the writes of messages.
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func newJSONRPCConn(r io.Reader, w io.Writer) *jsonrpcConn {
+	return &jsonrpcConn{r: bufio.NewReader(r), w: w}
+}
+
+// read returns the next message, or io.EOF when the input is closed.
+func (c *jsonrpcConn) read() (*jsonrpcMessage, error) {
+	header, err := textproto.NewReader(c.r).ReadMIMEHeader()
+	if err != nil {
+		if err == io.EOF && len(header) == 0 {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("reading message header: %w", err)
+	}
+	length, err := strconv.Atoi(strings.TrimSpace(header.Get("Content-Length")))
+	if err != nil || length < 0 {
+		return nil, fmt.Errorf("invalid Content-Length %q", header.Get("Content-Length"))
+	}
+	body := make([]byte, length)
+	if _, err := io.ReadFull(c.r, body); err != nil {
+		return nil, fmt.Errorf("reading message content: %w", err)
+	}
+	msg := &jsonrpcMessage{}
+	if err := json.Unmarshal(body, msg); err != nil {
+		return nil, &jsonrpcError{Code: jsonrpcParseError, Message: err.Error()}
+	}
+	return msg, nil
+}
+
+func (c *jsonrpcConn) write(msg *jsonrpcMessage) error {
+	msg.JSONRPC = "2.0"
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, err := fmt.Fprintf(c.w, "Content-Length: %d\r\n\r\n", len(body)); err != nil {
+		return err
+	}
+	_, err = c.w.Write(body)
+	return err
+}
+
+// reply sends the response to a request.
+func (c *jsonrpcConn) reply(id *json.RawMessage, result interface{}, rpcErr *jsonrpcError) error {
+	msg := &jsonrpcMessage{ID: id, Error: rpcErr}
+	if rpcErr == nil {
+		b, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+		msg.Result = b
+	}
+	return c.write(msg)
+}
+
+// notify sends a notification.
+func (c *jsonrpcConn) notify(method string, params interface{}) error {
+	b, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return c.write(&jsonrpcMessage{Method: method, Params: b})
+}
+
+// The subset of the Language Server Protocol types used by the server.
+
+type lspInitializeResult struct {
+	Capabilities lspServerCapabilities `json:"capabilities"`
+	ServerInfo   lspServerInfo         `json:"serverInfo"`
+}
+
+type lspServerCapabilities struct {
+	TextDocumentSync   lspTextDocumentSyncOptions `json:"textDocumentSync"`
+	CodeActionProvider lspCodeActionOptions       `json:"codeActionProvider"`
+}
+
+// lspTextDocumentSyncFull is the sync kind where the client sends the full
+// content of a document on every change.
+const lspTextDocumentSyncFull = 1
+
+type lspTextDocumentSyncOptions struct {
+	OpenClose bool           `json:"openClose"`
+	Change    int            `json:"change"`
+	Save      lspSaveOptions `json:"save"`
+}
+
+type lspSaveOptions struct {
+	IncludeText bool `json:"includeText"`
+}
+
+type lspCodeActionOptions struct {
+	CodeActionKinds []string `json:"codeActionKinds"`
+}
+
+type lspServerInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type lspTextDocumentItem struct {
+	URI     string `json:"uri"`
+	Version int    `json:"version"`
+	Text    string `json:"text"`
+}
+
+type lspTextDocumentIdentifier struct {
+	URI string `json:"uri"`
+}
+
+type lspDidOpenParams struct {
+	TextDocument lspTextDocumentItem `json:"textDocument"`
+}
+
+type lspDidChangeParams struct {
+	TextDocument   lspTextDocumentItem            `json:"textDocument"`
+	ContentChanges []lspTextDocumentContentChange `json:"contentChanges"`
+}
+
+type lspTextDocumentContentChange struct {
+	Text string `json:"text"`
+}
+
+type lspDidSaveParams struct {
+	TextDocument lspTextDocumentIdentifier `json:"textDocument"`
+	Text         *string                   `json:"text,omitempty"`
+}
+
+type lspDidCloseParams struct {
+	TextDocument lspTextDocumentIdentifier `json:"textDocument"`
+}
+
+// lspPosition is a zero-based position, where the character counts UTF-16
+// code units.
+type lspPosition struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+type lspRange struct {
+	Start lspPosition `json:"start"`
+	End   lspPosition `json:"end"`
+}
+
+// Diagnostic severities.
+const (
+	lspSeverityError       = 1
+	lspSeverityWarning     = 2
+	lspSeverityInformation = 3
+)
+
+type lspDiagnostic struct {
+	Range           lspRange            `json:"range"`
+	Severity        int                 `json:"severity"`
+	Code            string              `json:"code,omitempty"`
+	CodeDescription *lspCodeDescription `json:"codeDescription,omitempty"`
+	Source          string              `json:"source"`
+	Message         string              `json:"message"`
+}
+
+type lspCodeDescription struct {
+	Href string `json:"href"`
+}
+
+type lspPublishDiagnosticsParams struct {
+	URI         string          `json:"uri"`
+	Version     *int            `json:"version,omitempty"`
+	Diagnostics []lspDiagnostic `json:"diagnostics"`
+}
+
+type lspCodeActionParams struct {
+	TextDocument lspTextDocumentIdentifier `json:"textDocument"`
+	Range        lspRange                  `json:"range"`
+	Context      lspCodeActionContext      `json:"context"`
+}
+
+type lspCodeActionContext struct {
+	Diagnostics []lspDiagnostic `json:"diagnostics"`
+}
+
+type lspCodeAction struct {
+	Title       string           `json:"title"`
+	Kind        string           `json:"kind"`
+	Diagnostics []lspDiagnostic  `json:"diagnostics,omitempty"`
+	IsPreferred bool             `json:"isPreferred,omitempty"`
+	Edit        lspWorkspaceEdit `json:"edit"`
+}
+
+type lspWorkspaceEdit struct {
+	Changes map[string][]lspTextEdit `json:"changes"`
+}
+
+type lspTextEdit struct {
+	Range   lspRange `json:"range"`
+	NewText string   `json:"newText"`
+}
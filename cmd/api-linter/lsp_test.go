@@ -0,0 +1,278 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// lspTestClient drives a language server in tests.
+type lspTestClient struct {
+	t      *testing.T
+	conn   *jsonrpcConn
+	nextID int
+}
+
+func (c *lspTestClient) notify(method string, params interface{}) {
+	c.t.Helper()
+	if err := c.conn.notify(method, params); err != nil {
+		c.t.Fatalf("notify(%s) returned error: %v", method, err)
+	}
+}
+
+// request sends a request and returns its result.
+func (c *lspTestClient) request(method string, params interface{}, result interface{}) {
+	c.t.Helper()
+	c.nextID++
+	id := json.RawMessage(strconv.Itoa(c.nextID))
+	b := mustMarshal(c.t, params)
+	if err := c.conn.write(&jsonrpcMessage{ID: &id, Method: method, Params: b}); err != nil {
+		c.t.Fatalf("request(%s) returned error: %v", method, err)
+	}
+	msg := c.read()
+	if msg.Error != nil {
+		c.t.Fatalf("request(%s) returned error: %v", method, msg.Error)
+	}
+	if result != nil {
+		if err := json.Unmarshal(msg.Result, result); err != nil {
+			c.t.Fatalf("request(%s) returned invalid result: %v", method, err)
+		}
+	}
+}
+
+// diagnostics reads the next published diagnostics.
+func (c *lspTestClient) diagnostics() lspPublishDiagnosticsParams {
+	c.t.Helper()
+	msg := c.read()
+	if msg.Method != "textDocument/publishDiagnostics" {
+		c.t.Fatalf("Got method %q, want publishDiagnostics", msg.Method)
+	}
+	var p lspPublishDiagnosticsParams
+	if err := json.Unmarshal(msg.Params, &p); err != nil {
+		c.t.Fatal(err)
+	}
+	return p
+}
+
+func (c *lspTestClient) read() *jsonrpcMessage {
+	c.t.Helper()
+	msg, err := c.conn.read()
+	if err != nil {
+		c.t.Fatalf("read() returned error: %v", err)
+	}
+	return msg
+}
+
+func mustMarshal(t *testing.T, v interface{}) []byte {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestLSP(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "test.proto")
+	// The server lints the editor buffers, not the files on disk.
+	if err := os.WriteFile(path, []byte("syntax = \"proto3\";\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	uri := pathToURI(path)
+
+	clientR, serverW := io.Pipe()
+	serverR, clientW := io.Pipe()
+	c := &cli{ProtoImportPaths: []string{dir}, DisabledRules: []string{"core::0191"}}
+	done := make(chan error, 1)
+	go func() {
+		done <- c.serveLSP(serverR, serverW, globalRules, globalConfigs)
+		serverW.Close()
+	}()
+	client := &lspTestClient{t: t, conn: newJSONRPCConn(clientR, clientW)}
+
+	var init lspInitializeResult
+	client.request("initialize", map[string]interface{}{"capabilities": map[string]interface{}{}}, &init)
+	if got := init.Capabilities.TextDocumentSync.Change; got != lspTextDocumentSyncFull {
+		t.Errorf("Got sync kind %d, want %d", got, lspTextDocumentSyncFull)
+	}
+	client.notify("initialized", map[string]interface{}{})
+
+	// Open a document with a problem.
+	client.notify("textDocument/didOpen", lspDidOpenParams{TextDocument: lspTextDocumentItem{
+		URI:     uri,
+		Version: 1,
+		Text:    "syntax = \"proto3\";\n\npackage test.v1;\n\n// A Foo.\nmessage Foo {\n  // A bar.\n  string fooBar = 1;\n}\n",
+	}})
+	diags := client.diagnostics()
+	if diags.URI != uri || len(diags.Diagnostics) != 1 {
+		t.Fatalf("Got diagnostics %+v, want one for %s", diags, uri)
+	}
+	d := diags.Diagnostics[0]
+	wantDiag := lspDiagnostic{
+		Range:           lspRange{Start: lspPosition{Line: 7, Character: 9}, End: lspPosition{Line: 7, Character: 15}},
+		Severity:        lspSeverityError,
+		Code:            "core::0140::lower-snake",
+		CodeDescription: &lspCodeDescription{Href: "https://linter.aip.dev/140/lower-snake"},
+		Source:          "api-linter",
+		Message:         d.Message,
+	}
+	if diff := cmp.Diff(wantDiag, d); diff != "" {
+		t.Errorf("diagnostic mismatch (-want +got):\n%s", diff)
+	}
+
+	// Ask for the quick fixes of the problem.
+	var actions []lspCodeAction
+	client.request("textDocument/codeAction", lspCodeActionParams{
+		TextDocument: lspTextDocumentIdentifier{URI: uri},
+		Range:        d.Range,
+		Context:      lspCodeActionContext{Diagnostics: []lspDiagnostic{d}},
+	}, &actions)
+	wantEdits := []map[string][]lspTextEdit{
+		{uri: {{Range: d.Range, NewText: "foo_bar"}}},
+		{uri: {{
+			Range:   lspRange{Start: lspPosition{Line: 7}, End: lspPosition{Line: 7}},
+			NewText: "  // (-- api-linter: core::0140::lower-snake=disabled --)\n",
+		}}},
+	}
+	if len(actions) != len(wantEdits) {
+		t.Fatalf("Got %d code actions, want %d: %+v", len(actions), len(wantEdits), actions)
+	}
+	for i, a := range actions {
+		if a.Kind != "quickfix" {
+			t.Errorf("Got kind %q, want quickfix", a.Kind)
+		}
+		if diff := cmp.Diff(wantEdits[i], a.Edit.Changes); diff != "" {
+			t.Errorf("code action %q mismatch (-want +got):\n%s", a.Title, diff)
+		}
+	}
+
+	// Change the document so that it no longer compiles.
+	client.notify("textDocument/didChange", lspDidChangeParams{
+		TextDocument:   lspTextDocumentItem{URI: uri, Version: 2},
+		ContentChanges: []lspTextDocumentContentChange{{Text: "syntax = \"proto3\";\n\nmessage Foo {\n"}},
+	})
+	diags = client.diagnostics()
+	if len(diags.Diagnostics) == 0 || diags.Diagnostics[0].Range.Start.Line != 3 {
+		t.Errorf("Got diagnostics %+v, want a compilation error on line 3", diags.Diagnostics)
+	}
+
+	// Closing the document clears its diagnostics.
+	client.notify("textDocument/didClose", lspDidCloseParams{TextDocument: lspTextDocumentIdentifier{URI: uri}})
+	if diags := client.diagnostics(); len(diags.Diagnostics) != 0 {
+		t.Errorf("Got diagnostics %+v after closing, want none", diags.Diagnostics)
+	}
+
+	client.request("shutdown", nil, nil)
+	client.notify("exit", nil)
+	if err := <-done; err != nil {
+		t.Errorf("serveLSP() returned error: %v", err)
+	}
+}
+
+func TestLSPConfigDiscovery(t *testing.T) {
+	dir := t.TempDir()
+	config := `
+- disabled_rules: [core]
+  custom_rules:
+    - name: bar-prefix
+      kind: message
+      assert:
+        name: '^Bar'
+      message: Messages must start with Bar.
+`
+	writeFiles(t, dir, map[string]string{
+		".git/HEAD":           "",
+		"api/api-linter.yaml": config,
+		"api/v1/test.proto":   "",
+	})
+	uri := pathToURI(filepath.Join(dir, "api", "v1", "test.proto"))
+
+	clientR, serverW := io.Pipe()
+	serverR, clientW := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		done <- (&cli{}).serveLSP(serverR, serverW, globalRules, globalConfigs)
+		serverW.Close()
+	}()
+	client := &lspTestClient{t: t, conn: newJSONRPCConn(clientR, clientW)}
+	client.request("initialize", map[string]interface{}{"capabilities": map[string]interface{}{}}, nil)
+
+	// The config next to the document disables the core rules and defines a
+	// custom rule.
+	text := "syntax = \"proto3\";\n\npackage test.v1;\n\nmessage Foo {\n  string fooBar = 1;\n}\n"
+	client.notify("textDocument/didOpen", lspDidOpenParams{TextDocument: lspTextDocumentItem{URI: uri, Version: 1, Text: text}})
+	var codes []string
+	for _, d := range client.diagnostics().Diagnostics {
+		codes = append(codes, d.Code)
+	}
+	if diff := cmp.Diff([]string{"custom::bar-prefix"}, codes); diff != "" {
+		t.Errorf("diagnostics mismatch (-want +got):\n%s", diff)
+	}
+
+	// A change of the config applies to the next lint of the document.
+	writeFiles(t, dir, map[string]string{"api/api-linter.yaml": "- disabled_rules: [core]\n"})
+	client.notify("textDocument/didChange", lspDidChangeParams{
+		TextDocument:   lspTextDocumentItem{URI: uri, Version: 2},
+		ContentChanges: []lspTextDocumentContentChange{{Text: text}},
+	})
+	if diags := client.diagnostics(); len(diags.Diagnostics) != 0 {
+		t.Errorf("Got diagnostics %+v, want none", diags.Diagnostics)
+	}
+
+	client.request("shutdown", nil, nil)
+	client.notify("exit", nil)
+	if err := <-done; err != nil {
+		t.Errorf("serveLSP() returned error: %v", err)
+	}
+}
+
+func TestLSPMethodNotFound(t *testing.T) {
+	clientR, serverW := io.Pipe()
+	serverR, clientW := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		done <- (&cli{}).serveLSP(serverR, serverW, globalRules, globalConfigs)
+		serverW.Close()
+	}()
+	conn := newJSONRPCConn(clientR, clientW)
+
+	id := json.RawMessage("1")
+	if err := conn.write(&jsonrpcMessage{ID: &id, Method: "textDocument/hover"}); err != nil {
+		t.Fatal(err)
+	}
+	msg, err := conn.read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Error == nil || msg.Error.Code != jsonrpcMethodNotFound {
+		t.Errorf("Got %+v, want a method not found error", msg)
+	}
+
+	// Exiting without a shutdown is an error.
+	if err := conn.notify("exit", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err == nil {
+		t.Errorf("serveLSP() returned no error, want one")
+	}
+}
@@ -42,6 +42,14 @@ func main() {
 }
 
 func runCLI(args []string) error {
+	// `api-linter lsp` runs a language server over stdio.
+	if len(args) > 0 && args[0] == "lsp" {
+		return newCli(args[1:]).serveLSP(os.Stdin, os.Stdout, globalRules, globalConfigs)
+	}
+	// `api-linter docs` writes the documentation pages of the rules.
+	if len(args) > 0 && args[0] == "docs" {
+		return runDocs(args[1:], globalRules, os.Stdout)
+	}
 	c := newCli(args)
 	return c.lint(globalRules, globalConfigs)
 }
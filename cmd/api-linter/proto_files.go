@@ -0,0 +1,128 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/googleapis/api-linter/v2/lint"
+)
+
+// protoInput is a proto file to lint, expanded from the arguments.
+type protoInput struct {
+	name string
+
+	// expanded is whether the file was found in a directory or by a glob
+	// pattern, rather than given by name.
+	expanded bool
+}
+
+// expandProtoFiles expands the directories and the glob patterns among the
+// arguments into the proto files that they contain or match, relative to the
+// proto paths. The other arguments are kept as they are.
+func (c *cli) expandProtoFiles() ([]protoInput, error) {
+	var inputs []protoInput
+	seen := map[string]bool{}
+	add := func(name string, expanded bool) {
+		if !seen[name] {
+			seen[name] = true
+			inputs = append(inputs, protoInput{name: name, expanded: expanded})
+		}
+	}
+	roots := resolveImports(c.ProtoImportPaths)
+	for _, arg := range c.ProtoFiles {
+		pattern, ok := protoFilePattern(arg, roots)
+		if !ok {
+			add(arg, false)
+			continue
+		}
+		if !doublestar.ValidatePattern(pattern) || path.IsAbs(pattern) || pattern == ".." || strings.HasPrefix(pattern, "../") {
+			return nil, fmt.Errorf("invalid proto file pattern %q: must be relative to a proto path", arg)
+		}
+		var names []string
+		for _, root := range roots {
+			matches, err := doublestar.Glob(os.DirFS(root), pattern, doublestar.WithFilesOnly())
+			if err != nil {
+				return nil, err
+			}
+			for _, m := range matches {
+				if !strings.HasSuffix(m, ".proto") {
+					continue
+				}
+				abs, err := filepath.Abs(filepath.Join(root, m))
+				if err != nil {
+					return nil, err
+				}
+				names = append(names, protoFileName(abs, roots))
+			}
+		}
+		if len(names) == 0 {
+			return nil, fmt.Errorf("no proto files found for %q", arg)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			add(name, true)
+		}
+	}
+	return inputs, nil
+}
+
+// protoFilePattern returns the glob pattern for an argument that is a glob
+// pattern or a directory under one of the proto paths.
+func protoFilePattern(arg string, roots []string) (string, bool) {
+	if strings.ContainsAny(arg, "*?[{") {
+		return path.Clean(filepath.ToSlash(arg)), true
+	}
+	if filepath.IsAbs(arg) {
+		return "", false
+	}
+	for _, root := range roots {
+		if info, err := os.Stat(filepath.Join(root, arg)); err == nil && info.IsDir() {
+			return path.Join(filepath.ToSlash(filepath.Clean(arg)), "**", "*.proto"), true
+		}
+	}
+	return "", false
+}
+
+// protoFileName returns the name of a proto file relative to the innermost
+// proto path that contains it, like the names that imports use.
+func protoFileName(abs string, roots []string) string {
+	var root, name string
+	for _, importPath := range roots {
+		if r, rel, ok := relativeToImportPath(importPath, abs); ok && len(r) > len(root) {
+			root, name = r, filepath.ToSlash(rel)
+		}
+	}
+	return name
+}
+
+// protoFileNames returns the names of the files to lint, leaving out the
+// expanded files that the configs ignore.
+func protoFileNames(inputs []protoInput, configs lint.Configs) []string {
+	names := []string{}
+	for _, in := range inputs {
+		if in.expanded && configs.IsPathIgnored(in.name) {
+			continue
+		}
+		names = append(names, in.name)
+	}
+	return names
+}
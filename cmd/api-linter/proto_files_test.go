@@ -0,0 +1,107 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/api-linter/v2/lint"
+)
+
+func TestExpandProtoFiles(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"protos/library/v1/library.proto":     "",
+		"protos/library/v1/resources.proto":   "",
+		"protos/library/v1/README.md":         "",
+		"protos/third_party/google/api.proto": "",
+		"other/shelf.proto":                   "",
+	})
+	protos, other := filepath.Join(root, "protos"), filepath.Join(root, "other")
+
+	tests := []struct {
+		name string
+		args []string
+		want []protoInput
+	}{
+		{
+			name: "Files",
+			args: []string{"library/v1/library.proto", "missing.proto"},
+			want: []protoInput{{name: "library/v1/library.proto"}, {name: "missing.proto"}},
+		},
+		{
+			name: "Directory",
+			args: []string{"library"},
+			want: []protoInput{
+				{name: "library/v1/library.proto", expanded: true},
+				{name: "library/v1/resources.proto", expanded: true},
+			},
+		},
+		{
+			name: "Glob",
+			args: []string{"**/l*.proto", "*.proto"},
+			want: []protoInput{
+				{name: "library/v1/library.proto", expanded: true},
+				{name: "shelf.proto", expanded: true},
+			},
+		},
+		{
+			name: "Deduplicated",
+			args: []string{"library/v1/library.proto", "library/v1"},
+			want: []protoInput{
+				{name: "library/v1/library.proto"},
+				{name: "library/v1/resources.proto", expanded: true},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &cli{ProtoImportPaths: []string{protos, other}, ProtoFiles: test.args}
+			got, err := c.expandProtoFiles()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, got, cmp.AllowUnexported(protoInput{})); diff != "" {
+				t.Errorf("expandProtoFiles() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	for _, arg := range []string{"nothing/**/*.proto", "../*.proto"} {
+		c := &cli{ProtoImportPaths: []string{protos}, ProtoFiles: []string{arg}}
+		if _, err := c.expandProtoFiles(); err == nil {
+			t.Errorf("expandProtoFiles(%q) succeeded, want an error", arg)
+		}
+	}
+}
+
+func TestProtoFileNames(t *testing.T) {
+	inputs := []protoInput{
+		{name: "third_party/a.proto"},
+		{name: "third_party/b.proto", expanded: true},
+		{name: "library/c.proto", expanded: true},
+	}
+	configs := lint.Configs{{IgnoredPaths: []string{"third_party/**"}}}
+	want := []string{"third_party/a.proto", "library/c.proto"}
+	if diff := cmp.Diff(want, protoFileNames(inputs, configs)); diff != "" {
+		t.Errorf("protoFileNames() mismatch (-want +got):\n%s", diff)
+	}
+	if got := protoFileNames(inputs, nil); len(got) != 3 || !strings.HasPrefix(got[1], "third_party/") {
+		t.Errorf("protoFileNames() without configs = %v, want all the files", got)
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/googleapis/api-linter/v2/lint"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+// runDocs implements `api-linter docs`, which writes the documentation page
+// of every rule, or checks that the pages are up to date.
+//
+// The page of a rule is docs/rules/<aip>/<name>.md. A missing page is
+// generated from the metadata of the rule, and the front matter of an
+// existing page is updated, leaving the hand-written content alone.
+func runDocs(args []string, rules lint.RuleRegistry, w io.Writer) error {
+	var dir string
+	var check bool
+	fs := pflag.NewFlagSet("api-linter docs", pflag.ExitOnError)
+	fs.StringVar(&dir, "dir", filepath.Join("docs", "rules"), "The directory of the rule documentation.")
+	fs.BoolVar(&check, "check", false, "Report the missing and outdated pages, without changing them.\nReturns an error if there are any.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var names []lint.RuleName
+	for name := range rules {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+
+	stale := 0
+	for _, name := range names {
+		m := lint.GetRuleMetadata(rules[name])
+		if m.AIP == 0 {
+			return fmt.Errorf("rule %q has no AIP number", name)
+		}
+		path := ruleDocPath(dir, m)
+		existing, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		var page []byte
+		var status string
+		if err != nil {
+			page, status = ruleDocPage(m), "missing"
+		} else {
+			if page, err = updateRuleDocPage(existing, m); err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			page = addRuleExamples(page, m.Examples)
+			if bytes.Equal(page, existing) {
+				continue
+			}
+			status = "outdated"
+		}
+
+		stale++
+		if check {
+			fmt.Fprintf(w, "%s: %s page for rule %s\n", path, status, name)
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, page, 0o644); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s: wrote %s page for rule %s\n", path, status, name)
+	}
+	if check && stale > 0 {
+		return fmt.Errorf("%d rule documentation pages are missing or outdated; run `api-linter docs` to update them", stale)
+	}
+	return nil
+}
+
+// ruleDocPath returns the path of the documentation page of a rule, such as
+// "docs/rules/0140/lower-snake.md".
+func ruleDocPath(dir string, m lint.RuleMetadata) string {
+	parts := strings.Split(string(m.Name), "::")
+	return filepath.Join(dir, fmt.Sprintf("%04d", m.AIP), parts[len(parts)-1]+".md")
+}
+
+// ruleFrontMatter is the "rule" section of the front matter of a rule page.
+type ruleFrontMatter struct {
+	AIP     int      `yaml:"aip"`
+	Name    []string `yaml:"name"`
+	Summary string   `yaml:"summary"`
+}
+
+// ruleFrontMatterLines returns the "rule" section of the front matter of the
+// page of a rule.
+func ruleFrontMatterLines(m lint.RuleMetadata) string {
+	parts := strings.Split(string(m.Name), "::")
+	for i, p := range parts {
+		// Quote the AIP number, which would otherwise lose its leading zeroes.
+		if strings.Trim(p, "0123456789") == "" {
+			parts[i] = "'" + p + "'"
+		}
+	}
+	summary, _ := yaml.Marshal(m.Description)
+	return fmt.Sprintf("rule:\n  aip: %d\n  name: [%s]\n  summary: %s", m.AIP, strings.Join(parts, ", "), summary)
+}
+
+// updateRuleDocPage returns the page with the "rule" section of its front
+// matter replaced, if it does not match the metadata of the rule.
+func updateRuleDocPage(page []byte, m lint.RuleMetadata) ([]byte, error) {
+	lines := strings.SplitAfter(string(page), "\n")
+	if len(lines) == 0 || lines[0] != "---\n" {
+		return nil, fmt.Errorf("missing front matter")
+	}
+	end := -1
+	for i := 1; i < len(lines); i++ {
+		if lines[i] == "---\n" {
+			end = i
+			break
+		}
+	}
+	if end < 0 {
+		return nil, fmt.Errorf("unterminated front matter")
+	}
+
+	var fm struct {
+		Rule ruleFrontMatter `yaml:"rule"`
+	}
+	if err := yaml.Unmarshal([]byte(strings.Join(lines[1:end], "")), &fm); err != nil {
+		return nil, fmt.Errorf("invalid front matter: %w", err)
+	}
+	want := ruleFrontMatter{
+		AIP:     m.AIP,
+		Name:    strings.Split(string(m.Name), "::"),
+		Summary: m.Description,
+	}
+	// Summaries may be wrapped over several lines.
+	fm.Rule.Summary = strings.Join(strings.Fields(fm.Rule.Summary), " ")
+	if reflect.DeepEqual(fm.Rule, want) {
+		return page, nil
+	}
+
+	// Replace the "rule" section, which spans up to the next line that is
+	// not indented, or add it at the start of the front matter.
+	start, stop := 1, 1
+	for i := 1; i < end; i++ {
+		if lines[i] == "rule:\n" {
+			start, stop = i, i+1
+			for stop < end && strings.HasPrefix(lines[stop], " ") {
+				stop++
+			}
+			break
+		}
+	}
+	var b strings.Builder
+	b.WriteString(strings.Join(lines[:start], ""))
+	b.WriteString(ruleFrontMatterLines(m))
+	b.WriteString(strings.Join(lines[stop:], ""))
+	return []byte(b.String()), nil
+}
+
+// addRuleExamples returns the page with the examples that it does not show
+// yet, at the end of its "Examples" section, or in a new section before the
+// "Disabling" one.
+func addRuleExamples(page []byte, examples []lint.RuleExample) []byte {
+	var missing []lint.RuleExample
+	for _, e := range examples {
+		if !bytes.Contains(page, []byte(strings.TrimSpace(e.Incorrect))) || !bytes.Contains(page, []byte(strings.TrimSpace(e.Correct))) {
+			missing = append(missing, e)
+		}
+	}
+	if len(missing) == 0 {
+		return page
+	}
+	// The examples go at the end of the "Examples" section, or in a new
+	// section before the "Disabling" one, or at the end of the page.
+	text := ruleExamples(missing)
+	pos := len(page)
+	if i := bytes.Index(page, []byte("\n## Examples\n")); i >= 0 {
+		start := i + len("\n## Examples\n")
+		if next := bytes.Index(page[start:], []byte("\n## ")); next >= 0 {
+			pos = start + next + 1
+		}
+	} else if i := bytes.Index(page, []byte("\n## Disabling\n")); i >= 0 {
+		pos, text = i+1, "## Examples\n\n"+text
+	} else {
+		text = "## Examples\n\n" + text
+	}
+	if pos == len(page) {
+		text = "\n" + strings.TrimSuffix(text, "\n")
+	}
+	return []byte(string(page[:pos]) + text + string(page[pos:]))
+}
+
+// ruleExamples returns the examples of a rule, as shown on its page.
+func ruleExamples(examples []lint.RuleExample) string {
+	var b strings.Builder
+	for _, e := range examples {
+		if e.Title != "" {
+			fmt.Fprintf(&b, "### %s\n\n", e.Title)
+		}
+		fmt.Fprintf(&b, "**Incorrect** code for this rule:\n\n```proto\n// Incorrect.\n%s\n```\n\n", strings.TrimSpace(e.Incorrect))
+		fmt.Fprintf(&b, "**Correct** code for this rule:\n\n```proto\n// Correct.\n%s\n```\n\n", strings.TrimSpace(e.Correct))
+	}
+	return b.String()
+}
+
+// ruleDocPage returns a new documentation page for a rule.
+func ruleDocPage(m lint.RuleMetadata) []byte {
+	parts := strings.Split(string(m.Name), "::")
+	path := fmt.Sprintf("%d/%s", m.AIP, parts[len(parts)-1])
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "---\n%spermalink: /%s\nredirect_from:\n  - /%04d/%s\n---\n\n", ruleFrontMatterLines(m), path, m.AIP, parts[len(parts)-1])
+	fmt.Fprintf(&b, "# %s\n\n", m.Name)
+	if m.Description != "" {
+		fmt.Fprintf(&b, "%s\n\n", m.Description)
+	}
+	fmt.Fprintf(&b, "This rule enforces [AIP-%d][].\n\n", m.AIP)
+
+	if len(m.Examples) > 0 {
+		fmt.Fprintf(&b, "## Examples\n\n%s", ruleExamples(m.Examples))
+	}
+
+	fmt.Fprintf(&b, `## Disabling
+
+If you need to violate this rule, use a leading comment above the descriptor.
+Remember to also include an [aip.dev/not-precedent][] comment explaining why.
+
+`+"```proto"+`
+// (-- api-linter: %s=disabled
+//     aip.dev/not-precedent: We need to do this because reasons. --)
+`+"```"+`
+
+If you need to violate this rule for an entire file, place the comment at the
+top of the file.
+
+[aip-%d]: https://aip.dev/%d
+[aip.dev/not-precedent]: https://aip.dev/not-precedent
+`, m.Name, m.AIP, m.AIP)
+	return []byte(b.String())
+}
@@ -0,0 +1,153 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/googleapis/api-linter/v2/lint"
+)
+
+// Every registered rule must have an up-to-date documentation page, which
+// shows the examples of the rule.
+func TestRuleDocsUpToDate(t *testing.T) {
+	var out bytes.Buffer
+	if err := runDocs([]string{"--check", "--dir", filepath.Join("..", "..", "docs", "rules")}, globalRules, &out); err != nil {
+		t.Errorf("%v\n%s", err, out.String())
+	}
+}
+
+// A representative set of rules that must have examples, so that their pages
+// are checked against them.
+var rulesWithExamples = []lint.RuleName{
+	"core::0123::name-never-optional",
+	"core::0126::unspecified",
+	"core::0126::upper-snake-values",
+	"core::0131::http-method",
+	"core::0140::lower-snake",
+	"core::0191::java-package",
+}
+
+func TestRuleExamples(t *testing.T) {
+	for _, name := range rulesWithExamples {
+		rule, ok := globalRules[name]
+		if !ok {
+			t.Errorf("Rule %q is not registered", name)
+			continue
+		}
+		examples := lint.GetRuleMetadata(rule).Examples
+		if len(examples) == 0 {
+			t.Errorf("Rule %q has no examples", name)
+		}
+		for _, e := range examples {
+			if strings.TrimSpace(e.Incorrect) == "" || strings.TrimSpace(e.Correct) == "" {
+				t.Errorf("Rule %q has an example without incorrect or correct code", name)
+			}
+		}
+	}
+}
+
+func TestRunDocs(t *testing.T) {
+	dir := t.TempDir()
+	rule := &lint.FieldRule{
+		Name: lint.NewRuleName(140, "lower-snake"),
+		RuleInfo: lint.RuleInfo{
+			Description: "Field names should use `snake_case`.",
+			Examples: []lint.RuleExample{{
+				Title:     "Single word",
+				Incorrect: "message Book {\n  int32 pageCount = 1;\n}\n",
+				Correct:   "message Book {\n  int32 page_count = 1;\n}\n",
+			}},
+		},
+	}
+	registry := lint.NewRuleRegistry()
+	if err := registry.Register(140, rule); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "0140", "lower-snake.md")
+
+	// A missing page is reported, then generated.
+	var out bytes.Buffer
+	if err := runDocs([]string{"--check", "--dir", dir}, registry, &out); err == nil || !strings.Contains(out.String(), "missing page for rule core::0140::lower-snake") {
+		t.Errorf("Got error %v and output %q, want a missing page", err, out.String())
+	}
+	if err := runDocs([]string{"--dir", dir}, registry, &out); err != nil {
+		t.Fatal(err)
+	}
+	page, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"---\nrule:\n  aip: 140\n  name: [core, '0140', lower-snake]\n  summary: Field names should use `snake_case`.\npermalink: /140/lower-snake\n",
+		"### Single word\n\n**Incorrect** code for this rule:\n\n```proto\n// Incorrect.\nmessage Book {\n  int32 pageCount = 1;\n}\n```",
+		"// (-- api-linter: core::0140::lower-snake=disabled",
+		"[aip-140]: https://aip.dev/140",
+	} {
+		if !bytes.Contains(page, []byte(want)) {
+			t.Errorf("Got page:\n%s\nwant it to contain %q", page, want)
+		}
+	}
+	if err := runDocs([]string{"--check", "--dir", dir}, registry, &out); err != nil {
+		t.Errorf("runDocs(--check) returned error %v after generating the pages", err)
+	}
+
+	// When the description changes, only the front matter is updated.
+	custom := bytes.Replace(page, []byte("## Disabling"), []byte("## Details\n\nHand-written.\n\n## Disabling"), 1)
+	if err := os.WriteFile(path, custom, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	rule.Description = "Field names must be: `snake_case`."
+	out.Reset()
+	if err := runDocs([]string{"--check", "--dir", dir}, registry, &out); err == nil || !strings.Contains(out.String(), "outdated page") {
+		t.Errorf("Got error %v and output %q, want an outdated page", err, out.String())
+	}
+	if err := runDocs([]string{"--dir", dir}, registry, &out); err != nil {
+		t.Fatal(err)
+	}
+	updated, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := bytes.Replace(custom, []byte("summary: Field names should use `snake_case`."), []byte("summary: 'Field names must be: `snake_case`.'"), 1)
+	if !bytes.Equal(updated, want) {
+		t.Errorf("Got page:\n%s\nwant:\n%s", updated, want)
+	}
+
+	// A new example is added to the examples of the page.
+	rule.Examples = append(rule.Examples, lint.RuleExample{
+		Incorrect: "message Book {\n  string authorID = 1;\n}\n",
+		Correct:   "message Book {\n  string author_id = 1;\n}\n",
+	})
+	out.Reset()
+	if err := runDocs([]string{"--check", "--dir", dir}, registry, &out); err == nil || !strings.Contains(out.String(), "outdated page") {
+		t.Errorf("Got error %v and output %q, want an outdated page", err, out.String())
+	}
+	if err := runDocs([]string{"--dir", dir}, registry, &out); err != nil {
+		t.Fatal(err)
+	}
+	if updated, err = os.ReadFile(path); err != nil {
+		t.Fatal(err)
+	}
+	want = bytes.Replace(want, []byte("## Details"), []byte("**Incorrect** code for this rule:\n\n```proto\n// Incorrect.\nmessage Book {\n  string authorID = 1;\n}\n```\n\n"+
+		"**Correct** code for this rule:\n\n```proto\n// Correct.\nmessage Book {\n  string author_id = 1;\n}\n```\n\n## Details"), 1)
+	if !bytes.Equal(updated, want) {
+		t.Errorf("Got page:\n%s\nwant:\n%s", updated, want)
+	}
+}
@@ -7,12 +7,20 @@ import (
 	"sort"
 
 	"github.com/googleapis/api-linter/v2/lint"
+	"github.com/googleapis/api-linter/v2/plugin"
 	"github.com/olekukonko/tablewriter"
 )
 
 type (
 	listedRule struct {
-		Name lint.RuleName
+		// Name has no tags, so that its key stays the same as in the
+		// listings that predate the other fields.
+		Name             lint.RuleName
+		Description      string `json:"description,omitempty" yaml:"description,omitempty"`
+		AIP              int    `json:"aip,omitempty" yaml:"aip,omitempty"`
+		Fixable          bool   `json:"fixable" yaml:"fixable"`
+		EnabledByDefault bool   `json:"enabled_by_default" yaml:"enabled_by_default"`
+		URL              string `json:"url,omitempty" yaml:"url,omitempty"`
 	}
 	listedRules       []listedRule
 	listedRulesByName []listedRule
@@ -25,11 +33,22 @@ func (a listedRulesByName) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (r listedRules) printSummaryTable() ([]byte, error) {
 	var buf bytes.Buffer
 	table := tablewriter.NewWriter(&buf)
-	table.SetHeader([]string{"Rule Name"})
+	table.SetHeader([]string{"Rule Name", "Default", "Fixable", "Description"})
 	table.SetCaption(true, fmt.Sprintf("Total Rules: %d", len(r)))
 	for _, rule := range r {
+		state := "enabled"
+		if !rule.EnabledByDefault {
+			state = "disabled"
+		}
+		fixable := "no"
+		if rule.Fixable {
+			fixable = "yes"
+		}
 		table.Append([]string{
 			string(rule.Name),
+			state,
+			fixable,
+			rule.Description,
 		})
 	}
 	table.Render()
@@ -37,15 +56,55 @@ func (r listedRules) printSummaryTable() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-func outputRules(formatType string) error {
+// listRules returns the metadata of the rules, sorted by name.
+func listRules(registry lint.RuleRegistry) listedRules {
 	rules := listedRules{}
-	for id := range globalRules {
+	for _, rule := range registry {
+		m := lint.GetRuleMetadata(rule)
 		rules = append(rules, listedRule{
-			Name: id,
+			Name:             m.Name,
+			Description:      m.Description,
+			AIP:              m.AIP,
+			Fixable:          m.Fixable,
+			EnabledByDefault: m.EnabledByDefault,
+			URL:              m.URL,
 		})
 	}
-
 	sort.Sort(listedRulesByName(rules))
+	return rules
+}
+
+// withExtraRules returns the rules, along with the custom rules defined in
+// the configs and the rules of the plugins. The given registry is left alone.
+func (c *cli) withExtraRules(rules lint.RuleRegistry, configs lint.Configs) (lint.RuleRegistry, error) {
+	custom, err := configs.CustomRules()
+	if err != nil {
+		return nil, err
+	}
+	if len(custom) == 0 && len(c.Plugins) == 0 {
+		return rules, nil
+	}
+	all := lint.NewRuleRegistry()
+	for name, rule := range rules {
+		all[name] = rule
+	}
+	if err := all.RegisterCustom(custom...); err != nil {
+		return nil, err
+	}
+	for _, path := range c.Plugins {
+		p, err := plugin.Load(path, configs)
+		if err != nil {
+			return nil, err
+		}
+		if err := all.RegisterPlugin(p.Rules()...); err != nil {
+			return nil, fmt.Errorf("plugin %s: %w", path, err)
+		}
+	}
+	return all, nil
+}
+
+func outputRules(registry lint.RuleRegistry, formatType string) error {
+	rules := listRules(registry)
 
 	// Determine the format for printing the results.
 	// YAML format is the default.
@@ -0,0 +1,73 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/api-linter/v2/lint"
+)
+
+func TestListRules(t *testing.T) {
+	registry := lint.NewRuleRegistry()
+	err := registry.Register(140, &lint.FieldRule{
+		Name: lint.NewRuleName(140, "lower-snake"),
+		RuleInfo: lint.RuleInfo{
+			Description: "Field names should use `snake_case`.",
+			Fixable:     true,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := registry.Register(2500, &lint.FileRule{Name: lint.NewRuleName(2500, "foo")}); err != nil {
+		t.Fatal(err)
+	}
+
+	rules := listRules(registry)
+	want := listedRules{
+		{Name: "cloud::2500::foo", AIP: 2500, URL: "https://linter.aip.dev/2500/foo"},
+		{
+			Name:             "core::0140::lower-snake",
+			Description:      "Field names should use `snake_case`.",
+			AIP:              140,
+			Fixable:          true,
+			EnabledByDefault: true,
+			URL:              "https://linter.aip.dev/140/lower-snake",
+		},
+	}
+	if diff := cmp.Diff(want, rules); diff != "" {
+		t.Errorf("listRules() mismatch (-want +got):\n%s", diff)
+	}
+
+	b, err := json.Marshal(rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"Name":"core::0140::lower-snake","description":"Field names should use `+"`snake_case`"+`.","aip":140,"fixable":true,"enabled_by_default":true`) {
+		t.Errorf("Got JSON %s, want the metadata of the rule", b)
+	}
+
+	table, err := rules.printSummaryTable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(table), "| core::0140::lower-snake | enabled  | yes     |") {
+		t.Errorf("Got table:\n%s\nwant a row with the metadata of the rule", table)
+	}
+}
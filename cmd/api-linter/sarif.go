@@ -0,0 +1,325 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/googleapis/api-linter/v2/internal"
+	"github.com/googleapis/api-linter/v2/lint"
+)
+
+// The SARIF 2.1.0 object model, limited to the properties the linter emits.
+// See https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool       sarifTool     `json:"tool"`
+	ColumnKind string        `json:"columnKind"`
+	Results    []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string                     `json:"name"`
+	Version        string                     `json:"version"`
+	InformationURI string                     `json:"informationUri"`
+	Rules          []sarifReportingDescriptor `json:"rules"`
+}
+
+type sarifReportingDescriptor struct {
+	ID               string        `json:"id"`
+	ShortDescription *sarifMessage `json:"shortDescription,omitempty"`
+	HelpURI          string        `json:"helpUri,omitempty"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+	Fixes     []sarifFix      `json:"fixes,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// sarifRegion is a span of text. Lines and columns are one-based, and the
+// end column is exclusive. Columns count Unicode code points.
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine"`
+	EndColumn   int `json:"endColumn"`
+}
+
+type sarifFix struct {
+	Description     sarifMessage          `json:"description"`
+	ArtifactChanges []sarifArtifactChange `json:"artifactChanges"`
+}
+
+type sarifArtifactChange struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Replacements     []sarifReplacement    `json:"replacements"`
+}
+
+type sarifReplacement struct {
+	DeletedRegion   sarifRegion   `json:"deletedRegion"`
+	InsertedContent *sarifMessage `json:"insertedContent,omitempty"`
+}
+
+// sarifFormatter writes the problems as a SARIF 2.1.0 log.
+type sarifFormatter struct {
+	// rules are the rules that ran, which the log describes even if they
+	// reported no problem.
+	rules []lint.ProtoRule
+	// source returns the contents of a file to lint, by name. The columns of
+	// the files it can not read are left as protoc reports them.
+	source func(name string) ([]byte, error)
+}
+
+// sarifFormatFunc returns the SARIF format, which describes the rules of the
+// registry that are enabled for any of the linted files, and reads the
+// sources of the problems from the overlay or the proto paths.
+func (c *cli) sarifFormatFunc(rules lint.RuleRegistry, configs lint.Configs, overlay sourceOverlay) formatFunc {
+	return func(i interface{}) ([]byte, error) {
+		responses, ok := i.([]lint.Response)
+		if !ok {
+			return json.Marshal(i)
+		}
+		f := sarifFormatter{source: c.sourceFunc(overlay)}
+		for _, rule := range rules {
+			for _, r := range responses {
+				if configs.IsRuleEnabled(string(rule.GetName()), r.FilePath) {
+					f.rules = append(f.rules, rule)
+					break
+				}
+			}
+		}
+		return f.format(responses)
+	}
+}
+
+// formatFunc returns the format function of the formatter.
+func (f sarifFormatter) formatFunc() formatFunc {
+	return func(i interface{}) ([]byte, error) {
+		switch v := i.(type) {
+		case []lint.Response:
+			return f.format(v)
+		default:
+			return json.Marshal(v)
+		}
+	}
+}
+
+// format returns lint problems as a SARIF 2.1.0 log.
+func (f sarifFormatter) format(responses []lint.Response) ([]byte, error) {
+	// Describe every rule that ran or reported a problem, in a stable order.
+	described := map[lint.RuleName]string{}
+	for _, rule := range f.rules {
+		described[rule.GetName()] = ""
+		if d, ok := rule.(lint.DescribedRule); ok {
+			described[rule.GetName()] = d.GetDescription()
+		}
+	}
+	for _, r := range responses {
+		for _, p := range r.Problems {
+			if _, ok := described[p.RuleID]; !ok {
+				described[p.RuleID] = ""
+			}
+		}
+	}
+	ruleIDs := make([]lint.RuleName, 0, len(described))
+	for id := range described {
+		ruleIDs = append(ruleIDs, id)
+	}
+	sort.Slice(ruleIDs, func(i, j int) bool { return ruleIDs[i] < ruleIDs[j] })
+	ruleIndexes := map[lint.RuleName]int{}
+	rules := make([]sarifReportingDescriptor, 0, len(ruleIDs))
+	for i, id := range ruleIDs {
+		ruleIndexes[id] = i
+		rule := sarifReportingDescriptor{
+			ID:      string(id),
+			HelpURI: lint.Problem{RuleID: id}.GetRuleURI(),
+		}
+		if d := described[id]; d != "" {
+			rule.ShortDescription = &sarifMessage{Text: d}
+		}
+		rules = append(rules, rule)
+	}
+
+	results := []sarifResult{}
+	sources := map[string][]byte{}
+	source := func(path string) []byte {
+		src, ok := sources[path]
+		if !ok && f.source != nil {
+			src, _ = f.source(path)
+			sources[path] = src
+		}
+		return src
+	}
+	for _, r := range responses {
+		for _, p := range r.Problems {
+			loc := sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: filepath.ToSlash(r.FilePath)}}
+			if region, ok := sarifRegionFromSpan(source(r.FilePath), problemSpan(p)); ok {
+				loc.Region = &region
+			}
+			results = append(results, sarifResult{
+				RuleID:    string(p.RuleID),
+				RuleIndex: ruleIndexes[p.RuleID],
+				Level:     sarifLevel(p.Severity),
+				Message:   sarifMessage{Text: p.Message},
+				Locations: []sarifLocation{{PhysicalLocation: loc}},
+				Fixes:     sarifFixes(r.FilePath, p, source),
+			})
+		}
+	}
+
+	return json.Marshal(sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           "api-linter",
+				Version:        internal.Version,
+				InformationURI: "https://linter.aip.dev/",
+				Rules:          rules,
+			}},
+			ColumnKind: "unicodeCodePoints",
+			Results:    results,
+		}},
+	})
+}
+
+// sarifLevel returns the SARIF level that matches a problem severity.
+func sarifLevel(s lint.Severity) string {
+	switch s {
+	case lint.SeverityWarning:
+		return "warning"
+	case lint.SeverityInfo:
+		return "note"
+	default:
+		return "error"
+	}
+}
+
+// sarifFixes converts the fixes of a problem, grouping the edits of each fix
+// by file. Edits without a file path apply to the file of the problem.
+func sarifFixes(filePath string, p lint.Problem, source func(path string) []byte) []sarifFix {
+	var fixes []sarifFix
+	for _, f := range p.GetFixes() {
+		fix := sarifFix{Description: sarifMessage{Text: f.Title}}
+		changes := map[string]int{}
+		for _, e := range f.Edits {
+			path := e.FilePath
+			if path == "" {
+				path = filePath
+			}
+			region, ok := sarifRegionFromSpan(source(path), e.Location.GetSpan())
+			if !ok {
+				continue
+			}
+			i, ok := changes[path]
+			if !ok {
+				i = len(fix.ArtifactChanges)
+				changes[path] = i
+				fix.ArtifactChanges = append(fix.ArtifactChanges, sarifArtifactChange{
+					ArtifactLocation: sarifArtifactLocation{URI: filepath.ToSlash(path)},
+				})
+			}
+			rep := sarifReplacement{DeletedRegion: region}
+			if e.NewText != "" {
+				rep.InsertedContent = &sarifMessage{Text: e.NewText}
+			}
+			fix.ArtifactChanges[i].Replacements = append(fix.ArtifactChanges[i].Replacements, rep)
+		}
+		if len(fix.ArtifactChanges) > 0 {
+			fixes = append(fixes, fix)
+		}
+	}
+	return fixes
+}
+
+// problemSpan returns the span of a problem, falling back to the location of
+// its descriptor. It returns nil if the descriptor has no source location.
+func problemSpan(p lint.Problem) []int32 {
+	if p.Location != nil {
+		return p.Location.GetSpan()
+	}
+	if p.Descriptor == nil {
+		return nil
+	}
+	loc := p.Descriptor.ParentFile().SourceLocations().ByDescriptor(p.Descriptor)
+	if loc.StartLine == 0 && loc.StartColumn == 0 && loc.EndLine == 0 && loc.EndColumn == 0 {
+		return nil
+	}
+	return []int32{int32(loc.StartLine), int32(loc.StartColumn), int32(loc.EndLine), int32(loc.EndColumn)}
+}
+
+// sarifRegionFromSpan converts a source code info span, which is zero-based,
+// into a SARIF region of the source.
+func sarifRegionFromSpan(src []byte, span []int32) (sarifRegion, bool) {
+	var startLine, startCol, endLine, endCol int
+	switch len(span) {
+	case 3:
+		startLine, startCol, endLine, endCol = int(span[0]), int(span[1]), int(span[0]), int(span[2])
+	case 4:
+		startLine, startCol, endLine, endCol = int(span[0]), int(span[1]), int(span[2]), int(span[3])
+	default:
+		return sarifRegion{}, false
+	}
+	lines := lineOffsets(src)
+	return sarifRegion{
+		StartLine:   startLine + 1,
+		StartColumn: sarifColumn(src, lines, startLine, startCol),
+		EndLine:     endLine + 1,
+		EndColumn:   sarifColumn(src, lines, endLine, endCol),
+	}, true
+}
+
+// sarifColumn converts a zero-based column of a line, which counts tabs up to
+// the next multiple of eight like protoc, into a one-based column that counts
+// Unicode code points. The column is kept if it is not in the source.
+func sarifColumn(src []byte, lines []int, line, col int) int {
+	if offset, ok := byteOffset(src, lines, line, col); ok {
+		return utf8.RuneCount(src[lines[line]:offset]) + 1
+	}
+	return col + 1
+}
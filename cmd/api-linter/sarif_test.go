@@ -0,0 +1,227 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/api-linter/v2/internal"
+	"github.com/googleapis/api-linter/v2/lint"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestFormatSARIF(t *testing.T) {
+	responses := []lint.Response{
+		{
+			FilePath: "foo/bar.proto",
+			Problems: []lint.Problem{
+				{
+					RuleID:     "core::0140::lower-snake",
+					Message:    "Field names should use lower_snake_case.",
+					Suggestion: "foo_bar",
+					Location:   &descriptorpb.SourceCodeInfo_Location{Span: []int32{5, 9, 15}},
+					Severity:   lint.SeverityWarning,
+				},
+				{
+					RuleID:   "core::0191::java-outer-classname",
+					Message:  "Proto files should set `option java_outer_classname`.",
+					Location: &descriptorpb.SourceCodeInfo_Location{Span: []int32{2, 0, 4, 1}},
+					Fixes: []lint.Fix{{
+						Title: "Add `option java_outer_classname`",
+						Edits: []lint.TextEdit{{
+							FilePath: "foo/bar.proto",
+							Location: &descriptorpb.SourceCodeInfo_Location{Span: []int32{2, 13, 13}},
+							NewText:  "\n\noption java_outer_classname = \"BarProto\";",
+						}},
+					}},
+				},
+			},
+		},
+		{
+			FilePath: "foo/baz.proto",
+			Problems: []lint.Problem{
+				{
+					RuleID:   "core::0140::lower-snake",
+					Message:  "Field names should use lower_snake_case.",
+					Severity: lint.SeverityInfo,
+				},
+			},
+		},
+	}
+
+	b, err := sarifFormatter{}.format(responses)
+	if err != nil {
+		t.Fatalf("format() returned error: %v", err)
+	}
+	var got sarifLog
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("json.Unmarshal() returned error: %v", err)
+	}
+
+	want := sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           "api-linter",
+				Version:        internal.Version,
+				InformationURI: "https://linter.aip.dev/",
+				Rules: []sarifReportingDescriptor{
+					{ID: "core::0140::lower-snake", HelpURI: "https://linter.aip.dev/140/lower-snake"},
+					{ID: "core::0191::java-outer-classname", HelpURI: "https://linter.aip.dev/191/java-outer-classname"},
+				},
+			}},
+			ColumnKind: "unicodeCodePoints",
+			Results: []sarifResult{
+				{
+					RuleID:    "core::0140::lower-snake",
+					RuleIndex: 0,
+					Level:     "warning",
+					Message:   sarifMessage{Text: "Field names should use lower_snake_case."},
+					Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{URI: "foo/bar.proto"},
+						Region:           &sarifRegion{StartLine: 6, StartColumn: 10, EndLine: 6, EndColumn: 16},
+					}}},
+					Fixes: []sarifFix{{
+						Description: sarifMessage{Text: "Replace with `foo_bar`"},
+						ArtifactChanges: []sarifArtifactChange{{
+							ArtifactLocation: sarifArtifactLocation{URI: "foo/bar.proto"},
+							Replacements: []sarifReplacement{{
+								DeletedRegion:   sarifRegion{StartLine: 6, StartColumn: 10, EndLine: 6, EndColumn: 16},
+								InsertedContent: &sarifMessage{Text: "foo_bar"},
+							}},
+						}},
+					}},
+				},
+				{
+					RuleID:    "core::0191::java-outer-classname",
+					RuleIndex: 1,
+					Level:     "error",
+					Message:   sarifMessage{Text: "Proto files should set `option java_outer_classname`."},
+					Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{URI: "foo/bar.proto"},
+						Region:           &sarifRegion{StartLine: 3, StartColumn: 1, EndLine: 5, EndColumn: 2},
+					}}},
+					Fixes: []sarifFix{{
+						Description: sarifMessage{Text: "Add `option java_outer_classname`"},
+						ArtifactChanges: []sarifArtifactChange{{
+							ArtifactLocation: sarifArtifactLocation{URI: "foo/bar.proto"},
+							Replacements: []sarifReplacement{{
+								DeletedRegion:   sarifRegion{StartLine: 3, StartColumn: 14, EndLine: 3, EndColumn: 14},
+								InsertedContent: &sarifMessage{Text: "\n\noption java_outer_classname = \"BarProto\";"},
+							}},
+						}},
+					}},
+				},
+				{
+					RuleID:    "core::0140::lower-snake",
+					RuleIndex: 0,
+					Level:     "note",
+					Message:   sarifMessage{Text: "Field names should use lower_snake_case."},
+					Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{URI: "foo/baz.proto"},
+					}}},
+				},
+			},
+		}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("format() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFormatSARIFEmpty(t *testing.T) {
+	b, err := sarifFormatter{}.format(nil)
+	if err != nil {
+		t.Fatalf("format() returned error: %v", err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("json.Unmarshal() returned error: %v", err)
+	}
+	runs := got["runs"].([]interface{})
+	if results := runs[0].(map[string]interface{})["results"]; results == nil {
+		t.Errorf("Expected an empty results array, got null")
+	}
+}
+
+func TestFormatSARIFRules(t *testing.T) {
+	// The enabled rules are described even if they report no problem, and
+	// the columns of the regions count tabs and multi-byte characters as
+	// one character.
+	src := "syntax = \"proto3\";\n\nmessage Foo {\n\t// é\n\tstring fooBar = 1; // é\n}\n"
+	f := sarifFormatter{
+		rules: []lint.ProtoRule{
+			&lint.FieldRule{Name: "core::0140::lower-snake", RuleInfo: lint.RuleInfo{Description: "Field names should use `snake_case`."}},
+			&lint.FileRule{Name: "core::0191::java-package"},
+		},
+		source: func(name string) ([]byte, error) {
+			if name != "foo.proto" {
+				return nil, os.ErrNotExist
+			}
+			return []byte(src), nil
+		},
+	}
+	b, err := f.format([]lint.Response{{
+		FilePath: "foo.proto",
+		Problems: []lint.Problem{
+			{
+				RuleID:   "core::0140::lower-snake",
+				Message:  "Field names should use lower_snake_case.",
+				Location: &descriptorpb.SourceCodeInfo_Location{Span: []int32{4, 15, 21}},
+			},
+			{
+				RuleID:   "core::0192::only-utf8",
+				Message:  "Comments must be valid UTF-8.",
+				Location: &descriptorpb.SourceCodeInfo_Location{Span: []int32{3, 8, 4, 31}},
+			},
+		},
+	}})
+	if err != nil {
+		t.Fatalf("format() returned error: %v", err)
+	}
+	var got sarifLog
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("json.Unmarshal() returned error: %v", err)
+	}
+
+	wantRules := []sarifReportingDescriptor{
+		{ID: "core::0140::lower-snake", ShortDescription: &sarifMessage{Text: "Field names should use `snake_case`."}, HelpURI: "https://linter.aip.dev/140/lower-snake"},
+		{ID: "core::0191::java-package", HelpURI: "https://linter.aip.dev/191/java-package"},
+		{ID: "core::0192::only-utf8", HelpURI: "https://linter.aip.dev/192/only-utf8"},
+	}
+	if diff := cmp.Diff(wantRules, got.Runs[0].Tool.Driver.Rules); diff != "" {
+		t.Errorf("rules mismatch (-want +got):\n%s", diff)
+	}
+	var indexes []int
+	var regions []sarifRegion
+	for _, r := range got.Runs[0].Results {
+		indexes = append(indexes, r.RuleIndex)
+		regions = append(regions, *r.Locations[0].PhysicalLocation.Region)
+	}
+	if diff := cmp.Diff([]int{0, 2}, indexes); diff != "" {
+		t.Errorf("rule indexes mismatch (-want +got):\n%s", diff)
+	}
+	wantRegions := []sarifRegion{
+		{StartLine: 5, StartColumn: 9, EndLine: 5, EndColumn: 15},
+		{StartLine: 4, StartColumn: 2, EndLine: 5, EndColumn: 25},
+	}
+	if diff := cmp.Diff(wantRegions, regions); diff != "" {
+		t.Errorf("regions mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -26,6 +26,7 @@ import (
 // printSummaryTable returns a summary table of violation counts.
 func printSummaryTable(responses []lint.Response) ([]byte, error) {
 	s := createSummary(responses)
+	severities := ruleSeverities(responses)
 
 	data := []summary{}
 	for ruleID, fileViolations := range s {
@@ -33,17 +34,18 @@ func printSummaryTable(responses []lint.Response) ([]byte, error) {
 		for _, count := range fileViolations {
 			totalViolations += count
 		}
-		data = append(data, summary{ruleID, totalViolations, len(fileViolations)})
+		data = append(data, summary{ruleID, severities[ruleID], totalViolations, len(fileViolations)})
 	}
 	sort.SliceStable(data, func(i, j int) bool { return data[i].violations < data[j].violations })
 
 	var buf bytes.Buffer
 	table := tablewriter.NewWriter(&buf)
-	table.SetHeader([]string{"Rule", "Total Violations", "Violated Files"})
+	table.SetHeader([]string{"Rule", "Severity", "Total Violations", "Violated Files"})
 	table.SetCaption(true, fmt.Sprintf("Linted %d proto files", len(responses)))
 	for _, d := range data {
 		table.Append([]string{
 			d.ruleID,
+			string(d.severity),
 			fmt.Sprintf("%d", d.violations),
 			fmt.Sprintf("%d", d.files),
 		})
@@ -68,8 +70,27 @@ func createSummary(responses []lint.Response) map[string]map[string]int {
 	return summary
 }
 
+// ruleSeverities returns the most serious severity reported for each rule.
+func ruleSeverities(responses []lint.Response) map[string]lint.Severity {
+	severities := make(map[string]lint.Severity)
+	for _, r := range responses {
+		for _, p := range r.Problems {
+			ruleID := string(p.RuleID)
+			severity := p.Severity
+			if severity == "" {
+				severity = lint.SeverityError
+			}
+			if current, ok := severities[ruleID]; !ok || !current.AtLeast(severity) {
+				severities[ruleID] = severity
+			}
+		}
+	}
+	return severities
+}
+
 type summary struct {
 	ruleID     string
+	severity   lint.Severity
 	violations int
 	files      int
 }
@@ -82,3 +82,31 @@ func TestCreateSummary(t *testing.T) {
 		}
 	}
 }
+
+func TestRuleSeverities(t *testing.T) {
+	data := []lint.Response{
+		{
+			FilePath: "example.proto",
+			Problems: []lint.Problem{
+				{RuleID: "a", Severity: lint.SeverityInfo},
+				{RuleID: "a", Severity: lint.SeverityWarning},
+				{RuleID: "b"},
+			},
+		},
+		{
+			FilePath: "example2.proto",
+			Problems: []lint.Problem{
+				{RuleID: "a", Severity: lint.SeverityInfo},
+				{RuleID: "c", Severity: lint.SeverityInfo},
+			},
+		},
+	}
+	want := map[string]lint.Severity{
+		"a": lint.SeverityWarning,
+		"b": lint.SeverityError,
+		"c": lint.SeverityInfo,
+	}
+	if diff := cmp.Diff(want, ruleSeverities(data)); diff != "" {
+		t.Errorf("ruleSeverities() mismatch (-want +got):\n%s", diff)
+	}
+}
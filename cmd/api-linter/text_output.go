@@ -0,0 +1,225 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/googleapis/api-linter/v2/lint"
+)
+
+// The ANSI escape codes of the text output.
+const (
+	ansiReset  = "\x1b[0m"
+	ansiBold   = "\x1b[1m"
+	ansiRed    = "\x1b[31m"
+	ansiGreen  = "\x1b[32m"
+	ansiYellow = "\x1b[33m"
+	ansiBlue   = "\x1b[34m"
+)
+
+// textFormatter writes the problems in a human-readable format, with the
+// source line of each problem and a marker under its span.
+type textFormatter struct {
+	// source returns the contents of a file to lint, by name.
+	source func(name string) ([]byte, error)
+	// color is whether to use ANSI colors.
+	color bool
+}
+
+// isTextFormat returns whether an output format is the text format.
+func isTextFormat(formatType string) bool {
+	switch strings.ToLower(formatType) {
+	case "text", "pretty":
+		return true
+	}
+	return false
+}
+
+// textFormatFunc returns the text format, which reads the sources of the
+// problems from the overlay or the proto paths, and uses colors if the
+// output is a terminal.
+func (c *cli) textFormatFunc(w *os.File, overlay sourceOverlay) formatFunc {
+	f := textFormatter{
+		source: c.sourceFunc(overlay),
+		color:  isTerminal(w) && os.Getenv("NO_COLOR") == "",
+	}
+	return f.formatFunc()
+}
+
+// sourceFunc returns a function that reads a file to lint, by name, from the
+// overlay or the proto paths.
+func (c *cli) sourceFunc(overlay sourceOverlay) func(name string) ([]byte, error) {
+	return func(name string) ([]byte, error) {
+		for _, importPath := range resolveImports(c.ProtoImportPaths) {
+			if b, err := overlay.read(filepath.Join(importPath, name)); err == nil {
+				return b, nil
+			}
+		}
+		return nil, os.ErrNotExist
+	}
+}
+
+// formatFunc returns the format function of the formatter.
+func (f textFormatter) formatFunc() formatFunc {
+	return func(i interface{}) ([]byte, error) {
+		switch v := i.(type) {
+		case []lint.Response:
+			return f.format(v), nil
+		case listedRules:
+			return v.printSummaryTable()
+		default:
+			return json.Marshal(v)
+		}
+	}
+}
+
+// isTerminal returns whether a file is a terminal.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// format returns the problems as text, followed by a summary of their
+// severities.
+func (f textFormatter) format(responses []lint.Response) []byte {
+	var buf bytes.Buffer
+	counts := map[lint.Severity]int{}
+	files := 0
+	for _, response := range responses {
+		if len(response.Problems) > 0 {
+			files++
+		}
+		var src []byte
+		for _, p := range response.Problems {
+			if src == nil {
+				src, _ = f.source(response.FilePath)
+			}
+			severity := p.Severity
+			if severity == "" {
+				severity = lint.SeverityError
+			}
+			counts[severity]++
+			f.writeProblem(&buf, response.FilePath, src, p, severity)
+		}
+	}
+
+	total := counts[lint.SeverityError] + counts[lint.SeverityWarning] + counts[lint.SeverityInfo]
+	if total == 0 {
+		fmt.Fprintf(&buf, "No problems found in %s.\n", countNoun(len(responses), "file", "files"))
+		return buf.Bytes()
+	}
+	fmt.Fprintf(&buf, "Found %s in %s: %s, %s, %s.\n",
+		countNoun(total, "problem", "problems"),
+		countNoun(files, "file", "files"),
+		f.paint(severityColor(lint.SeverityError), countNoun(counts[lint.SeverityError], "error", "errors")),
+		f.paint(severityColor(lint.SeverityWarning), countNoun(counts[lint.SeverityWarning], "warning", "warnings")),
+		f.paint(severityColor(lint.SeverityInfo), countNoun(counts[lint.SeverityInfo], "info", "info")),
+	)
+	return buf.Bytes()
+}
+
+// writeProblem writes a problem: its position, rule and message, then its
+// source line with a marker under its span, and its suggestion.
+func (f textFormatter) writeProblem(buf *bytes.Buffer, path string, src []byte, p lint.Problem, severity lint.Severity) {
+	color := severityColor(severity)
+	span := problemSpan(p)
+	if len(span) < 3 {
+		fmt.Fprintf(buf, "%s: %s: %s\n", f.paint(ansiBold, path), f.paint(color, string(p.RuleID)), p.Message)
+	} else {
+		line, col := int(span[0]), int(span[1])
+		fmt.Fprintf(buf, "%s: %s: %s\n", f.paint(ansiBold, fmt.Sprintf("%s:%d:%d", path, line+1, col+1)), f.paint(color, string(p.RuleID)), p.Message)
+
+		lines := strings.Split(string(src), "\n")
+		if line < len(lines) {
+			text := strings.TrimRight(lines[line], "\r")
+			// The columns count tabs up to the next multiple of eight, like
+			// protoc. The span ends on its line, or at the end of the line if
+			// it spans several lines.
+			start, end := textOffset(text, col), len(text)
+			if len(span) == 3 {
+				end = textOffset(text, int(span[2]))
+			}
+			gutter := strconv.Itoa(line + 1)
+			fmt.Fprintf(buf, " %s | %s\n", gutter, text)
+			fmt.Fprintf(buf, " %s | %s\n", strings.Repeat(" ", len(gutter)), f.paint(color, marker(text, start, end)))
+		}
+	}
+	if p.Suggestion != "" {
+		fmt.Fprintf(buf, "   = suggestion: %s\n", f.paint(ansiGreen, p.Suggestion))
+	}
+}
+
+// textOffset returns the byte offset of a column of a line, or the end of
+// the line if the column is past it.
+func textOffset(text string, col int) int {
+	if offset, ok := byteOffset([]byte(text), []int{0}, 0, col); ok {
+		return offset
+	}
+	return len(text)
+}
+
+// marker returns the carets under the span of a line between two offsets,
+// or a single caret for an empty span. The tabs before the span are kept so
+// that the carets line up with the source.
+func marker(text string, start, end int) string {
+	start = min(max(start, 0), len(text))
+	end = min(max(end, start), len(text))
+	var b strings.Builder
+	for _, r := range text[:start] {
+		if r == '\t' {
+			b.WriteRune('\t')
+		} else {
+			b.WriteRune(' ')
+		}
+	}
+	b.WriteString(strings.Repeat("^", max(utf8.RuneCountInString(text[start:end]), 1)))
+	return b.String()
+}
+
+// paint returns a string in the given ANSI style, if colors are used.
+func (f textFormatter) paint(style, s string) string {
+	if !f.color {
+		return s
+	}
+	return style + s + ansiReset
+}
+
+// severityColor returns the ANSI color of a severity.
+func severityColor(s lint.Severity) string {
+	switch s {
+	case lint.SeverityWarning:
+		return ansiYellow
+	case lint.SeverityInfo:
+		return ansiBlue
+	default:
+		return ansiRed
+	}
+}
+
+// countNoun returns a count followed by the singular or plural of a noun.
+func countNoun(n int, singular, plural string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, singular)
+	}
+	return fmt.Sprintf("%d %s", n, plural)
+}
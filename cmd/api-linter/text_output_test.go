@@ -0,0 +1,152 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/api-linter/v2/lint"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestFormatText(t *testing.T) {
+	source := func(name string) ([]byte, error) {
+		switch name {
+		case "example.proto":
+			return []byte("syntax = \"proto3\";\nmessage Book {\n  string bookName = 1;\n}\n"), nil
+		case "tabs.proto":
+			return []byte("message Foo {\n\tstring BadName = 1;\n\t\t// Ünïcode.\n}"), nil
+		}
+		return nil, os.ErrNotExist
+	}
+	tests := []struct {
+		name  string
+		data  []lint.Response
+		color bool
+		want  string
+	}{
+		{
+			name: "NoProblems",
+			data: []lint.Response{{FilePath: "example.proto"}},
+			want: "No problems found in 1 file.\n",
+		},
+		{
+			name: "Problems",
+			data: []lint.Response{
+				{
+					FilePath: "example.proto",
+					Problems: []lint.Problem{
+						{
+							RuleID:     "core::0140::lower-snake",
+							Message:    "Field `bookName` must use lower_snake_case.",
+							Suggestion: "book_name",
+							Location:   &descriptorpb.SourceCodeInfo_Location{Span: []int32{2, 9, 17}},
+							Severity:   lint.SeverityError,
+						},
+						{
+							RuleID:   "core::0123::multi-line",
+							Message:  "Multi-line span.",
+							Location: &descriptorpb.SourceCodeInfo_Location{Span: []int32{1, 8, 3, 1}},
+							Severity: lint.SeverityWarning,
+						},
+						{
+							RuleID:   "core::0123::empty-span",
+							Message:  "Empty span.",
+							Location: &descriptorpb.SourceCodeInfo_Location{Span: []int32{0, 0, 0}},
+							Severity: lint.SeverityWarning,
+						},
+					},
+				},
+				{
+					FilePath: "missing.proto",
+					Problems: []lint.Problem{
+						{
+							RuleID:   "core::0123::no-source",
+							Message:  "No source.",
+							Location: &descriptorpb.SourceCodeInfo_Location{Span: []int32{4, 2, 6}},
+							Severity: lint.SeverityInfo,
+						},
+					},
+				},
+			},
+			want: "example.proto:3:10: core::0140::lower-snake: Field `bookName` must use lower_snake_case.\n" +
+				" 3 |   string bookName = 1;\n" +
+				"   |          ^^^^^^^^\n" +
+				"   = suggestion: book_name\n" +
+				"example.proto:2:9: core::0123::multi-line: Multi-line span.\n" +
+				" 2 | message Book {\n" +
+				"   |         ^^^^^^\n" +
+				"example.proto:1:1: core::0123::empty-span: Empty span.\n" +
+				" 1 | syntax = \"proto3\";\n" +
+				"   | ^\n" +
+				"missing.proto:5:3: core::0123::no-source: No source.\n" +
+				"Found 4 problems in 2 files: 1 error, 2 warnings, 1 info.\n",
+		},
+		{
+			// The columns count tabs up to the next multiple of eight.
+			name: "Tabs",
+			data: []lint.Response{
+				{
+					FilePath: "tabs.proto",
+					Problems: []lint.Problem{
+						{
+							RuleID:   "core::0140::lower-snake",
+							Message:  "Bad name.",
+							Location: &descriptorpb.SourceCodeInfo_Location{Span: []int32{1, 15, 22}},
+							Severity: lint.SeverityError,
+						},
+						{
+							RuleID:   "core::0192::comment",
+							Message:  "Bad comment.",
+							Location: &descriptorpb.SourceCodeInfo_Location{Span: []int32{2, 19, 26}},
+							Severity: lint.SeverityError,
+						},
+					},
+				},
+			},
+			want: "tabs.proto:2:16: core::0140::lower-snake: Bad name.\n" +
+				" 2 | \tstring BadName = 1;\n" +
+				"   | \t       ^^^^^^^\n" +
+				"tabs.proto:3:20: core::0192::comment: Bad comment.\n" +
+				" 3 | \t\t// Ünïcode.\n" +
+				"   | \t\t   ^^^^^^^\n" +
+				"Found 2 problems in 1 file: 2 errors, 0 warnings, 0 info.\n",
+		},
+		{
+			name: "Color",
+			data: []lint.Response{
+				{
+					FilePath: "example.proto",
+					Problems: []lint.Problem{
+						{RuleID: "core::0123::no-location", Message: "No location.", Severity: lint.SeverityWarning},
+					},
+				},
+			},
+			color: true,
+			want: "\x1b[1mexample.proto\x1b[0m: \x1b[33mcore::0123::no-location\x1b[0m: No location.\n" +
+				"Found 1 problem in 1 file: \x1b[31m0 errors\x1b[0m, \x1b[33m1 warning\x1b[0m, \x1b[34m0 info\x1b[0m.\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := textFormatter{source: source, color: test.color}.format(test.data)
+			if diff := cmp.Diff(test.want, string(got)); diff != "" {
+				t.Errorf("format() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
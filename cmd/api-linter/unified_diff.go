@@ -0,0 +1,308 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around each change.
+const diffContext = 3
+
+type diffOpKind int
+
+const (
+	diffEqual diffOpKind = iota
+	diffDelete
+	diffInsert
+)
+
+// diffOp is a single line of an edit script.
+type diffOp struct {
+	kind diffOpKind
+	// The indexes of the line in the old and new files. Only the index of
+	// the file that contains the line is meaningful.
+	a, b int
+}
+
+// unifiedDiff returns the unified diff between two versions of a file, or an
+// empty string if they are identical.
+func unifiedDiff(path string, a, b []byte) string {
+	aLines, bLines := splitLines(string(a)), splitLines(string(b))
+	ops := diffLines(aLines, bLines)
+
+	var sb strings.Builder
+	for _, h := range diffHunks(ops) {
+		if sb.Len() == 0 {
+			fmt.Fprintf(&sb, "--- a/%s\n+++ b/%s\n", path, path)
+		}
+		hunk := ops[h[0]:h[1]]
+		aStart, aCount, bStart, bCount := hunkRange(hunk)
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n", formatRange(aStart, aCount), formatRange(bStart, bCount))
+		for _, op := range hunk {
+			switch op.kind {
+			case diffEqual:
+				writeDiffLine(&sb, " ", aLines[op.a])
+			case diffDelete:
+				writeDiffLine(&sb, "-", aLines[op.a])
+			case diffInsert:
+				writeDiffLine(&sb, "+", bLines[op.b])
+			}
+		}
+	}
+	return sb.String()
+}
+
+// splitLines splits text into lines, keeping the line endings.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func writeDiffLine(sb *strings.Builder, prefix, line string) {
+	sb.WriteString(prefix)
+	sb.WriteString(line)
+	if !strings.HasSuffix(line, "\n") {
+		sb.WriteString("\n\\ No newline at end of file\n")
+	}
+}
+
+// diffLines returns the shortest edit script that turns a into b, using
+// Myers' algorithm.
+func diffLines(a, b []string) []diffOp {
+	n, m := len(a), len(b)
+	max := n + m
+	offset := max + 1
+	v := make([]int, 2*max+2)
+	var trace [][]int
+
+search:
+	for d := 0; d <= max; d++ {
+		trace = append(trace, append([]int(nil), v...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x, y = x+1, y+1
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	// Walk the trace backwards to recover the edit script.
+	var ops []diffOp
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x, y = x-1, y-1
+			ops = append(ops, diffOp{diffEqual, x, y})
+		}
+		if d > 0 {
+			if x == prevX {
+				ops = append(ops, diffOp{diffInsert, x, prevY})
+			} else {
+				ops = append(ops, diffOp{diffDelete, prevX, y})
+			}
+		}
+		x, y = prevX, prevY
+	}
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops
+}
+
+// diffHunks groups the changes of an edit script into hunks, and returns the
+// [start, end) ranges of the hunks in the script.
+func diffHunks(ops []diffOp) [][2]int {
+	var hunks [][2]int
+	for i := 0; i < len(ops); i++ {
+		if ops[i].kind == diffEqual {
+			continue
+		}
+		start := max(i-diffContext, 0)
+		end := i + 1
+		// Extend the hunk while the next change is close enough to share
+		// the context lines.
+		for j := end; j < len(ops); j++ {
+			if ops[j].kind != diffEqual {
+				end = j + 1
+			} else if j-end >= 2*diffContext {
+				break
+			}
+		}
+		end = min(end+diffContext, len(ops))
+		if len(hunks) > 0 && start <= hunks[len(hunks)-1][1] {
+			hunks[len(hunks)-1][1] = end
+		} else {
+			hunks = append(hunks, [2]int{start, end})
+		}
+		i = end - 1
+	}
+	return hunks
+}
+
+// hunkRange returns the zero-based start line and line count of a hunk in
+// the old and new files.
+func hunkRange(hunk []diffOp) (aStart, aCount, bStart, bCount int) {
+	aStart, bStart = -1, -1
+	for _, op := range hunk {
+		if op.kind != diffInsert {
+			if aStart < 0 {
+				aStart = op.a
+			}
+			aCount++
+		}
+		if op.kind != diffDelete {
+			if bStart < 0 {
+				bStart = op.b
+			}
+			bCount++
+		}
+	}
+	// A side without lines is positioned at the line before the hunk.
+	if aStart < 0 {
+		aStart = hunk[0].a
+	}
+	if bStart < 0 {
+		bStart = hunk[0].b
+	}
+	return aStart, aCount, bStart, bCount
+}
+
+// formatRange formats a hunk range, with one-based line numbers.
+func formatRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+// changedLines holds the lines changed by a diff, keyed by the path of the
+// changed file. The lines are one-based line numbers in the new version of
+// the file.
+type changedLines map[string]map[int]bool
+
+// hunkHeader matches the header of a hunk, such as "@@ -1,2 +1,3 @@".
+var hunkHeader = regexp.MustCompile(`^@@ -\d+(?:,(\d+))? \+(\d+)(?:,(\d+))? @@`)
+
+// parseUnifiedDiff returns the lines added or modified by a unified diff,
+// such as the output of `git diff`. Deleted files are ignored.
+func parseUnifiedDiff(r io.Reader) (changedLines, error) {
+	changed := changedLines{}
+	var path, oldPath string
+	var line, oldRemaining, newRemaining int
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		text := scanner.Text()
+
+		// Lines inside a hunk, counted against the ranges of its header.
+		if oldRemaining > 0 || newRemaining > 0 {
+			switch {
+			case strings.HasPrefix(text, "+"):
+				if path != "" {
+					changed[path][line] = true
+				}
+				line++
+				newRemaining--
+			case strings.HasPrefix(text, "-"):
+				oldRemaining--
+			case strings.HasPrefix(text, `\`):
+				// "\ No newline at end of file"
+			default:
+				line++
+				oldRemaining--
+				newRemaining--
+			}
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(text, "--- "):
+			oldPath = diffPath(text[4:])
+		case strings.HasPrefix(text, "+++ "):
+			path = diffPath(text[4:])
+			// Strip the prefixes that git adds by default.
+			if strings.HasPrefix(oldPath, "a/") && strings.HasPrefix(path, "b/") {
+				path = path[2:]
+			}
+			if path == "/dev/null" {
+				path = ""
+			} else if _, ok := changed[path]; !ok {
+				changed[path] = map[int]bool{}
+			}
+		case strings.HasPrefix(text, "@@ "):
+			m := hunkHeader.FindStringSubmatch(text)
+			if m == nil {
+				return nil, fmt.Errorf("invalid hunk header %q", text)
+			}
+			oldRemaining, newRemaining = 1, 1
+			if m[1] != "" {
+				oldRemaining, _ = strconv.Atoi(m[1])
+			}
+			line, _ = strconv.Atoi(m[2])
+			if m[3] != "" {
+				newRemaining, _ = strconv.Atoi(m[3])
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return changed, nil
+}
+
+// diffPath returns the path of a "---" or "+++" line, without the
+// timestamp that some tools add after a tab.
+func diffPath(s string) string {
+	if i := strings.IndexByte(s, '\t'); i >= 0 {
+		s = s[:i]
+	}
+	return strings.TrimSpace(s)
+}
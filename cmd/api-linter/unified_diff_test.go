@@ -0,0 +1,86 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	lines := func(n int) []string {
+		var l []string
+		for i := 1; i <= n; i++ {
+			l = append(l, strings.Repeat("x", i))
+		}
+		return l
+	}
+	join := func(l []string) string { return strings.Join(l, "\n") + "\n" }
+	replace := func(l []string, i int, s string) []string {
+		l = append([]string(nil), l...)
+		l[i] = s
+		return l
+	}
+
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{"Identical", "a\nb\n", "a\nb\n", ""},
+		{
+			name: "Replace",
+			a:    join(lines(5)),
+			b:    join(replace(lines(5), 2, "y")),
+			want: "--- a/f.proto\n+++ b/f.proto\n@@ -1,5 +1,5 @@\n x\n xx\n-xxx\n+y\n xxxx\n xxxxx\n",
+		},
+		{
+			name: "SeparateHunks",
+			a:    join(lines(20)),
+			b:    join(replace(replace(lines(20), 1, "y"), 17, "z")),
+			want: "--- a/f.proto\n+++ b/f.proto\n" +
+				"@@ -1,5 +1,5 @@\n x\n-xx\n+y\n xxx\n xxxx\n xxxxx\n" +
+				"@@ -15,6 +15,6 @@\n " + strings.Join(lines(20)[14:17], "\n ") + "\n-" + lines(20)[17] + "\n+z\n " + strings.Join(lines(20)[18:], "\n ") + "\n",
+		},
+		{
+			name: "Insert",
+			a:    "a\n",
+			b:    "// Comment.\na\n",
+			want: "--- a/f.proto\n+++ b/f.proto\n@@ -1 +1,2 @@\n+// Comment.\n a\n",
+		},
+		{
+			name: "InsertIntoEmptyFile",
+			a:    "",
+			b:    "a\n",
+			want: "--- a/f.proto\n+++ b/f.proto\n@@ -0,0 +1 @@\n+a\n",
+		},
+		{
+			name: "NoNewlineAtEndOfFile",
+			a:    "a",
+			b:    "b",
+			want: "--- a/f.proto\n+++ b/f.proto\n@@ -1 +1 @@\n-a\n\\ No newline at end of file\n+b\n\\ No newline at end of file\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := unifiedDiff("f.proto", []byte(test.a), []byte(test.b))
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("unifiedDiff() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/googleapis/api-linter/v2/lint"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// watchInterval is how often the watched files are checked for changes.
+var watchInterval = 500 * time.Millisecond
+
+// watch lints the files, then lints them again each time the files to lint,
+// the files that they import, the descriptor sets or the config files
+// change, until stop is closed. New files in the directories and glob
+// patterns to lint are found too. Only the files affected by a change are
+// compiled again.
+//
+// The errors of a run are printed to stderr, and do not stop the watch.
+func (c *cli) watch(rules lint.RuleRegistry, configs lint.Configs, exitStatusSeverity lint.Severity, stop <-chan struct{}) error {
+	args := c.ProtoFiles
+	linked := &linkCache{}
+	var last fileStamps
+	var imports, configPaths []string
+	for {
+		c.ProtoFiles = args
+		stamps := c.watchedFiles(imports, configPaths)
+		if changed := stamps.changed(last); last == nil || len(changed) > 0 {
+			c.invalidate(linked, changed)
+			c.ProtoFiles = args
+			err := c.lintOnce(rules, configs, exitStatusSeverity, linked)
+			// The compile errors alone are reported as problems.
+			if _, ok := err.(*compileError); err != nil && !ok && !errors.Is(err, ExitForLintFailure) {
+				fmt.Fprintln(os.Stderr, err)
+			}
+			// The imports are only known once the files are compiled, and the
+			// config files once the files are expanded.
+			imports = linked.paths()
+			var cerr *compileError
+			if errors.As(err, &cerr) {
+				for _, e := range cerr.errs {
+					imports = append(imports, e.GetPosition().Filename)
+				}
+			}
+			if configPaths, err = c.configFiles(); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+			}
+
+			// The files that are watched from now on keep the states they had
+			// before the run, so that a change during the run is not missed.
+			c.ProtoFiles = args
+			next := c.watchedFiles(imports, configPaths)
+			for path := range next {
+				if stamp, ok := stamps[path]; ok {
+					next[path] = stamp
+				}
+			}
+			last = next
+		}
+		select {
+		case <-stop:
+			return nil
+		case <-time.After(watchInterval):
+		}
+	}
+}
+
+// fileStamp is the state of a file that tells whether it changed.
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// fileStamps are the states of files, keyed by their absolute paths.
+type fileStamps map[string]fileStamp
+
+// add adds the state of a file, if it exists.
+func (s fileStamps) add(path string) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return
+	}
+	if info, err := os.Stat(abs); err == nil && !info.IsDir() {
+		s[abs] = fileStamp{modTime: info.ModTime(), size: info.Size()}
+	}
+}
+
+// changed returns the paths of the files that were added, changed or
+// removed since the previous states.
+func (s fileStamps) changed(previous fileStamps) []string {
+	var paths []string
+	for path, stamp := range s {
+		if prev, ok := previous[path]; !ok || prev != stamp {
+			paths = append(paths, path)
+		}
+	}
+	for path := range previous {
+		if _, ok := s[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// watchedFiles returns the states of the files to lint, with the
+// directories and glob patterns expanded, of the given imports, of the
+// descriptor sets and of the config files.
+func (c *cli) watchedFiles(imports, configPaths []string) fileStamps {
+	stamps := fileStamps{}
+	var names []string
+	// An error of the expansion is reported by the run.
+	if inputs, err := c.expandProtoFiles(); err == nil {
+		names = protoFileNames(inputs, nil)
+	}
+	for _, name := range append(names, imports...) {
+		if path, ok := c.findOnDisk(name); ok {
+			stamps.add(path)
+		}
+	}
+	for _, path := range c.ProtoDescPath {
+		stamps.add(path)
+	}
+	if c.ConfigPath != "" {
+		stamps.add(c.ConfigPath)
+	}
+	for _, path := range configPaths {
+		stamps.add(path)
+	}
+	return stamps
+}
+
+// invalidate removes the files affected by the changed files from the link
+// cache. A changed descriptor set affects all of them.
+func (c *cli) invalidate(linked *linkCache, changed []string) {
+	var names []string
+	for _, path := range changed {
+		for _, descPath := range c.ProtoDescPath {
+			if abs, err := filepath.Abs(descPath); err == nil && abs == path {
+				linked.files = nil
+				return
+			}
+		}
+		if !strings.HasSuffix(path, ".proto") {
+			continue
+		}
+		for _, importPath := range resolveImports(c.ProtoImportPaths) {
+			if _, rel, ok := relativeToImportPath(importPath, path); ok {
+				names = append(names, filepath.ToSlash(rel))
+			}
+		}
+	}
+	linked.invalidate(names)
+}
+
+// linkCache holds the linked files of previous compilations, keyed by path,
+// so that they are not compiled again. A nil cache holds no file.
+type linkCache struct {
+	files map[string]protoreflect.FileDescriptor
+}
+
+// find returns the linked file with the given path, if any.
+func (lc *linkCache) find(path string) (protoreflect.FileDescriptor, bool) {
+	if lc == nil {
+		return nil, false
+	}
+	fd, ok := lc.files[path]
+	return fd, ok
+}
+
+// add adds a linked file, along with the files that it imports.
+func (lc *linkCache) add(fd protoreflect.FileDescriptor) {
+	if lc == nil {
+		return
+	}
+	if lc.files == nil {
+		lc.files = map[string]protoreflect.FileDescriptor{}
+	}
+	if _, ok := lc.files[fd.Path()]; ok {
+		return
+	}
+	lc.files[fd.Path()] = fd
+	for i := 0; i < fd.Imports().Len(); i++ {
+		lc.add(fd.Imports().Get(i).FileDescriptor)
+	}
+}
+
+// paths returns the paths of the linked files.
+func (lc *linkCache) paths() []string {
+	var paths []string
+	for path := range lc.files {
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+// invalidate removes the files with the given paths, and the files that
+// import them, directly or not.
+func (lc *linkCache) invalidate(paths []string) {
+	stale := map[string]bool{}
+	for _, path := range paths {
+		stale[path] = true
+	}
+	for changed := true; changed; {
+		changed = false
+		for path, fd := range lc.files {
+			for i := 0; i < fd.Imports().Len() && !stale[path]; i++ {
+				if stale[fd.Imports().Get(i).Path()] {
+					stale[path], changed = true, true
+				}
+			}
+		}
+	}
+	for path := range stale {
+		delete(lc.files, path)
+	}
+}
+
+// resolver returns a resolver that finds the linked files in the cache
+// before falling back to the given resolver.
+func (lc *linkCache) resolver(next protocompile.Resolver) protocompile.Resolver {
+	return protocompile.ResolverFunc(func(path string) (protocompile.SearchResult, error) {
+		if fd, ok := lc.find(path); ok {
+			return protocompile.SearchResult{Desc: fd}, nil
+		}
+		return next.FindFileByPath(path)
+	})
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/api-linter/v2/lint"
+)
+
+func TestLinkCache(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"a.proto": "syntax = \"proto3\";\npackage test;\nimport \"b.proto\";\nmessage A { B b = 1; }\n",
+		"b.proto": "syntax = \"proto3\";\npackage test;\nmessage B {}\n",
+		"c.proto": "syntax = \"proto3\";\npackage test;\nmessage C {}\n",
+	})
+	c := &cli{ProtoImportPaths: []string{root}, ProtoFiles: []string{"a.proto", "c.proto"}}
+	linked := &linkCache{}
+	first, err := c.getDescriptorsFromSource(nil, linked)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"a.proto", "b.proto", "c.proto"}, cachedPaths(linked)); diff != "" {
+		t.Errorf("cached files mismatch (-want +got):\n%s", diff)
+	}
+
+	// Only the file that changed is compiled again, with the files that it
+	// imports taken from the cache.
+	linked.invalidate([]string{"a.proto"})
+	if diff := cmp.Diff([]string{"b.proto", "c.proto"}, cachedPaths(linked)); diff != "" {
+		t.Errorf("cached files mismatch (-want +got):\n%s", diff)
+	}
+	b, _ := linked.find("b.proto")
+	second, err := c.getDescriptorsFromSource(nil, linked)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second[0] == first[0] {
+		t.Errorf("Expected a.proto to be compiled again.")
+	}
+	if second[0].Imports().Get(0).FileDescriptor != b {
+		t.Errorf("Expected the import of a.proto to be reused.")
+	}
+	if second[1] != first[1] {
+		t.Errorf("Expected c.proto to be reused.")
+	}
+
+	// A change to an import affects the files that import it.
+	linked.invalidate([]string{"b.proto"})
+	if diff := cmp.Diff([]string{"c.proto"}, cachedPaths(linked)); diff != "" {
+		t.Errorf("cached files mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func cachedPaths(linked *linkCache) []string {
+	var paths []string
+	for path, fd := range linked.files {
+		// The standard imports are cached too.
+		if !strings.HasPrefix(path, "google/") {
+			paths = append(paths, fd.Path())
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func TestWatch(t *testing.T) {
+	defer func(interval time.Duration) { watchInterval = interval }(watchInterval)
+	watchInterval = 10 * time.Millisecond
+
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"a.proto": "syntax = \"proto3\";\npackage test;\nimport \"b.proto\";\nmessage A { B bField = 1; }\n",
+		"b.proto": "syntax = \"proto3\";\npackage test;\nmessage B {}\n",
+	})
+	out := filepath.Join(root, "out.yaml")
+	c := &cli{ProtoImportPaths: []string{root}, ProtoFiles: []string{"a.proto"}, OutputPath: out}
+	stop := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- c.watch(lint.NewRuleRegistry(), nil, lint.SeverityInfo, stop)
+	}()
+	waitForOutput(t, out, "file_path: a.proto")
+
+	// No rule is registered, so the new run is told apart by its compile
+	// error, in a file that is only imported.
+	writeFiles(t, root, map[string]string{
+		"b.proto": "syntax = \"proto3\";\npackage test;\nmessage B { Missing m = 1; }\n",
+	})
+	waitForOutput(t, out, "rule_id: compile-error")
+
+	close(stop)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWatchedFiles(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"api/a.proto":    "syntax = \"proto3\";\npackage test;\nimport \"common/b.proto\";\nmessage A { B b = 1; }\n",
+		"common/b.proto": "syntax = \"proto3\";\npackage test;\nmessage B {}\n",
+		"other/c.proto":  "syntax = \"proto3\";\npackage test;\nmessage C {}\n",
+		"config.yaml":    "[]\n",
+	})
+	c := &cli{ProtoImportPaths: []string{root}, ProtoFiles: []string{"api/a.proto"}, ConfigPath: filepath.Join(root, "config.yaml")}
+	linked := &linkCache{}
+	if _, err := c.getDescriptorsFromSource(nil, linked); err != nil {
+		t.Fatal(err)
+	}
+
+	// The files to lint and their imports are watched, but not the other
+	// files under the proto paths.
+	c.ProtoFiles = []string{"api"}
+	var got []string
+	for path := range c.watchedFiles(linked.paths(), nil) {
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, filepath.ToSlash(rel))
+	}
+	sort.Strings(got)
+	if diff := cmp.Diff([]string{"api/a.proto", "common/b.proto", "config.yaml"}, got); diff != "" {
+		t.Errorf("watched files mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// waitForOutput waits for a file to contain a string.
+func waitForOutput(t *testing.T, path, want string) {
+	t.Helper()
+	var got []byte
+	for deadline := time.Now().Add(10 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		got, _ = os.ReadFile(path)
+		if strings.Contains(string(got), want) {
+			return
+		}
+	}
+	t.Fatalf("Expected %q in the output, got:\n%s", want, got)
+}
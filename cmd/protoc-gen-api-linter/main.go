@@ -0,0 +1,271 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The command `protoc-gen-api-linter` is a protoc plugin that checks Google
+// APIs defined in Protobuf files, following the API Improvement Proposals
+// defined in https://aip.dev.
+//
+// It lints the files to generate, and takes its options from the plugin
+// parameter, as a comma-separated list of `key=value` pairs:
+//
+//	protoc --api-linter_out=output_file=results.json:. foo.proto
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/googleapis/api-linter/v2/lint"
+	"github.com/googleapis/api-linter/v2/rules"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+	"gopkg.in/yaml.v3"
+)
+
+// defaultOutputFile is the name of the report file, when neither a file nor
+// error_on_problems is requested.
+const defaultOutputFile = "api-linter-results"
+
+func main() {
+	if err := run(os.Stdin, os.Stdout); err != nil {
+		log.Fatalln(err)
+	}
+}
+
+// run reads a CodeGeneratorRequest from r, and writes the
+// CodeGeneratorResponse to w.
+func run(r io.Reader, w io.Writer) error {
+	in, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	req := &pluginpb.CodeGeneratorRequest{}
+	if err := proto.Unmarshal(in, req); err != nil {
+		return fmt.Errorf("reading the CodeGeneratorRequest: %w", err)
+	}
+
+	resp := generate(req)
+	resp.SupportedFeatures = proto.Uint64(uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL | pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS))
+	resp.MinimumEdition = proto.Int32(int32(dpb.Edition_EDITION_PROTO2))
+	resp.MaximumEdition = proto.Int32(int32(dpb.Edition_EDITION_2023))
+
+	out, err := proto.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(out)
+	return err
+}
+
+// generate lints the files of the request. Problems with the request are
+// reported as an error in the response, which protoc prints.
+func generate(req *pluginpb.CodeGeneratorRequest) *pluginpb.CodeGeneratorResponse {
+	opts, err := parseParameter(req.GetParameter())
+	if err != nil {
+		return errorResponse(err)
+	}
+	configs, err := opts.configs()
+	if err != nil {
+		return errorResponse(err)
+	}
+
+	// The request contains every file to generate along with all of their
+	// dependencies, in topological order.
+	files, err := protodesc.NewFiles(&dpb.FileDescriptorSet{File: req.GetProtoFile()})
+	if err != nil {
+		return errorResponse(fmt.Errorf("building file descriptors: %w", err))
+	}
+	var fileDescriptors []protoreflect.FileDescriptor
+	for _, name := range req.GetFileToGenerate() {
+		fd, err := files.FindFileByPath(name)
+		if err != nil {
+			return errorResponse(err)
+		}
+		fileDescriptors = append(fileDescriptors, fd)
+	}
+
+	registry := lint.NewRuleRegistry()
+	if err := rules.Add(registry); err != nil {
+		return errorResponse(fmt.Errorf("registering rules: %w", err))
+	}
+	// The custom rules of the config file are registered as in the command
+	// line.
+	custom, err := configs.CustomRules()
+	if err != nil {
+		return errorResponse(err)
+	}
+	if err := registry.RegisterCustom(custom...); err != nil {
+		return errorResponse(err)
+	}
+	results, err := lint.New(registry, configs).LintProtos(fileDescriptors...)
+	if err != nil {
+		return errorResponse(err)
+	}
+
+	resp := &pluginpb.CodeGeneratorResponse{}
+	if opts.outputFile != "" {
+		content, err := opts.marshal(results)
+		if err != nil {
+			return errorResponse(err)
+		}
+		resp.File = append(resp.File, &pluginpb.CodeGeneratorResponse_File{
+			Name:    proto.String(opts.outputFile),
+			Content: proto.String(string(content)),
+		})
+	}
+	if opts.errorOnProblems {
+		if msg := problemsMessage(results, opts.errorSeverity); msg != "" {
+			resp.Error = proto.String(msg)
+		}
+	}
+	return resp
+}
+
+func errorResponse(err error) *pluginpb.CodeGeneratorResponse {
+	return &pluginpb.CodeGeneratorResponse{Error: proto.String(err.Error())}
+}
+
+// options are the options given in the plugin parameter.
+type options struct {
+	configPath      string
+	enabledRules    []string
+	disabledRules   []string
+	outputFormat    string
+	outputFile      string
+	errorOnProblems bool
+	errorSeverity   lint.Severity
+}
+
+// parseParameter parses the plugin parameter, a comma-separated list of
+// options:
+//
+//   - config=PATH: the linter config file, including its custom rules.
+//   - enable_rule=NAME, disable_rule=NAME: enable or disable a rule. May be
+//     given multiple times.
+//   - output_format=yaml|json: the format of the report file.
+//   - output_file=NAME: the name of the report file.
+//   - error_on_problems: fail the generation when problems are found.
+//   - error_severity=error|warning|info: the minimum severity of the problems
+//     that fail the generation.
+//
+// Without output_file or error_on_problems, the results are written to
+// "api-linter-results.yaml" (or ".json").
+func parseParameter(parameter string) (options, error) {
+	opts := options{outputFormat: "yaml", errorSeverity: lint.SeverityInfo}
+	for _, param := range strings.Split(parameter, ",") {
+		if param == "" {
+			continue
+		}
+		key, value, hasValue := strings.Cut(param, "=")
+		if !hasValue && key != "error_on_problems" {
+			return opts, fmt.Errorf("parameter %q requires a value", key)
+		}
+		switch key {
+		case "config":
+			opts.configPath = value
+		case "enable_rule":
+			opts.enabledRules = append(opts.enabledRules, value)
+		case "disable_rule":
+			opts.disabledRules = append(opts.disabledRules, value)
+		case "output_format":
+			format := strings.ToLower(value)
+			if format == "yml" {
+				format = "yaml"
+			}
+			if format != "yaml" && format != "json" {
+				return opts, fmt.Errorf("unsupported output_format %q: must be \"yaml\" or \"json\"", value)
+			}
+			opts.outputFormat = format
+		case "output_file":
+			opts.outputFile = value
+		case "error_on_problems":
+			opts.errorOnProblems = !hasValue || value == "true"
+		case "error_severity":
+			sev, err := lint.ParseSeverity(value)
+			if err != nil {
+				return opts, err
+			}
+			opts.errorSeverity = sev
+		default:
+			return opts, fmt.Errorf("unknown parameter %q", key)
+		}
+	}
+	if opts.outputFile == "" && !opts.errorOnProblems {
+		opts.outputFile = defaultOutputFile + "." + opts.outputFormat
+	}
+	return opts, nil
+}
+
+// configs returns the linter configs from the config file and the rule
+// toggles.
+func (opts options) configs() (lint.Configs, error) {
+	configs := lint.Configs{}
+	if opts.configPath != "" {
+		config, err := lint.ReadConfigsFromFile(opts.configPath)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, config...)
+	}
+	// Combine the rule toggles into a single config so that enable/disable
+	// precedence is handled correctly.
+	if len(opts.enabledRules) > 0 || len(opts.disabledRules) > 0 {
+		configs = append(configs, lint.Config{
+			EnabledRules:  opts.enabledRules,
+			DisabledRules: opts.disabledRules,
+		})
+	}
+	return configs, nil
+}
+
+func (opts options) marshal(results []lint.Response) ([]byte, error) {
+	if opts.outputFormat == "json" {
+		return json.Marshal(results)
+	}
+	return yaml.Marshal(results)
+}
+
+// problemsMessage returns a description of the problems that are at least as
+// serious as the given severity, one per line, or an empty string if there
+// are none.
+func problemsMessage(results []lint.Response, severity lint.Severity) string {
+	var lines []string
+	for _, r := range results {
+		for _, p := range r.Problems {
+			if !p.Severity.AtLeast(severity) {
+				continue
+			}
+			line, col := problemStart(p)
+			lines = append(lines, fmt.Sprintf("%s:%d:%d: %s: %s", path.Clean(r.FilePath), line+1, col+1, p.RuleID, p.Message))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// problemStart returns the zero-based line and column of the problem.
+func problemStart(p lint.Problem) (int, int) {
+	if span := p.Location.GetSpan(); len(span) >= 2 {
+		return int(span[0]), int(span[1])
+	}
+	loc := p.Descriptor.ParentFile().SourceLocations().ByDescriptor(p.Descriptor)
+	return loc.StartLine, loc.StartColumn
+}
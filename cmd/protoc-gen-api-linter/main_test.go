@@ -0,0 +1,199 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+const testProto = `syntax = "proto3";
+
+package test.v1;
+
+option java_multiple_files = true;
+option java_outer_classname = "TestProto";
+option java_package = "com.test.v1";
+
+// A Foo.
+message Foo {
+  // A bar.
+  string fooBar = 1;
+}
+`
+
+// newRequest compiles the test proto into a CodeGeneratorRequest, the same
+// way protoc does.
+func newRequest(t *testing.T, parameter string) *pluginpb.CodeGeneratorRequest {
+	t.Helper()
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			Accessor: protocompile.SourceAccessorFromMap(map[string]string{"test.proto": testProto}),
+		}),
+		SourceInfoMode: protocompile.SourceInfoStandard,
+	}
+	files, err := compiler.Compile(context.Background(), "test.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Add the dependencies before the files that import them.
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{"test.proto"},
+		Parameter:      proto.String(parameter),
+	}
+	seen := map[string]bool{}
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		for i := 0; i < fd.Imports().Len(); i++ {
+			add(fd.Imports().Get(i).FileDescriptor)
+		}
+		req.ProtoFile = append(req.ProtoFile, protodesc.ToFileDescriptorProto(fd))
+	}
+	add(files[0])
+	return req
+}
+
+func runPlugin(t *testing.T, req *pluginpb.CodeGeneratorRequest) *pluginpb.CodeGeneratorResponse {
+	t.Helper()
+	in, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := run(bytes.NewReader(in), &out); err != nil {
+		t.Fatalf("run() returned error: %v", err)
+	}
+	resp := &pluginpb.CodeGeneratorResponse{}
+	if err := proto.Unmarshal(out.Bytes(), resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetSupportedFeatures()&uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL) == 0 {
+		t.Errorf("The plugin does not support proto3 optional fields.")
+	}
+	return resp
+}
+
+func TestPlugin(t *testing.T) {
+	tests := []struct {
+		name       string
+		parameter  string
+		wantFile   string
+		wantInFile string
+		wantError  string
+	}{
+		{
+			name:       "DefaultReport",
+			wantFile:   "api-linter-results.yaml",
+			wantInFile: "rule_id: core::0140::lower-snake",
+		},
+		{
+			name:       "JSONReport",
+			parameter:  "output_format=json,output_file=lint/results.json",
+			wantFile:   "lint/results.json",
+			wantInFile: `"rule_id":"core::0140::lower-snake"`,
+		},
+		{
+			name:       "DisabledRule",
+			parameter:  "disable_rule=core::0140::lower-snake",
+			wantFile:   "api-linter-results.yaml",
+			wantInFile: "problems: []",
+		},
+		{
+			name:      "ErrorOnProblems",
+			parameter: "error_on_problems",
+			wantError: "test.proto:12:10: core::0140::lower-snake: ",
+		},
+		{
+			name:      "ErrorOnProblemsBelowSeverity",
+			parameter: "error_on_problems,error_severity=error,config=testdata/warnings.yaml",
+		},
+		{
+			name:       "CustomRules",
+			parameter:  "config=testdata/custom_rules.yaml",
+			wantFile:   "api-linter-results.yaml",
+			wantInFile: "rule_id: custom::foo-suffix",
+		},
+		{
+			name:      "UnknownParameter",
+			parameter: "foo=bar",
+			wantError: `unknown parameter "foo"`,
+		},
+		{
+			name:      "InvalidFormat",
+			parameter: "output_format=xml",
+			wantError: `unsupported output_format "xml"`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := runPlugin(t, newRequest(t, test.parameter))
+			if test.wantError == "" && resp.Error != nil {
+				t.Fatalf("Got error %q, want none", resp.GetError())
+			}
+			if !strings.Contains(resp.GetError(), test.wantError) {
+				t.Errorf("Got error %q, want it to contain %q", resp.GetError(), test.wantError)
+			}
+			if test.wantFile == "" {
+				if len(resp.GetFile()) != 0 {
+					t.Errorf("Got %d files, want none", len(resp.GetFile()))
+				}
+				return
+			}
+			if len(resp.GetFile()) != 1 {
+				t.Fatalf("Got %d files, want 1", len(resp.GetFile()))
+			}
+			f := resp.GetFile()[0]
+			if f.GetName() != test.wantFile {
+				t.Errorf("Got file %q, want %q", f.GetName(), test.wantFile)
+			}
+			if !strings.Contains(f.GetContent(), test.wantInFile) {
+				t.Errorf("Got content %q, want it to contain %q", f.GetContent(), test.wantInFile)
+			}
+		})
+	}
+}
+
+func TestPluginJSONReportIsValid(t *testing.T) {
+	resp := runPlugin(t, newRequest(t, "output_format=json"))
+	var results []map[string]interface{}
+	if err := json.Unmarshal([]byte(resp.GetFile()[0].GetContent()), &results); err != nil {
+		t.Fatalf("The report is not valid JSON: %v", err)
+	}
+	if len(results) != 1 || results[0]["file_path"] != "test.proto" {
+		t.Errorf("Got %v, want the results of test.proto", results)
+	}
+}
+
+func TestPluginEditions(t *testing.T) {
+	resp := runPlugin(t, newRequest(t, ""))
+	if got, want := resp.GetMaximumEdition(), int32(dpb.Edition_EDITION_2023); got != want {
+		t.Errorf("Got maximum edition %d, want %d", got, want)
+	}
+}
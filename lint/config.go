@@ -58,6 +58,31 @@ type Config struct {
 	// - an entire AIP category: `core`
 	// - all rules: `all`
 	DisabledRules []string `json:"disabled_rules" yaml:"disabled_rules"`
+
+	// The severity of the problems reported by a rule, keyed by rule name.
+	// Valid severities are `error` (the default), `warning` and `info`.
+	// Keys can be given in any of the formats accepted by `enabled_rules`;
+	// if several keys match a rule, the most specific (longest) one wins.
+	RuleSeverities map[string]Severity `json:"rule_severities" yaml:"rule_severities"`
+
+	// The options of the rules that accept options, keyed by the full rule
+	// name, such as `core::0140::reserved-words`. The options set by later
+	// configs take precedence over the options set by earlier ones.
+	RuleOptions map[string]RuleOptions `json:"rule_options" yaml:"rule_options"`
+
+	// Rules defined in the config, without Go code. They are named
+	// `custom::<name>`, and only run on the paths matched by this config.
+	CustomRules []CustomRule `json:"custom_rules" yaml:"custom_rules"`
+
+	// Require a reason on the comments that disable rules, such as
+	// `api-linter: core::0140::abbreviations=disabled reason="legacy field"`.
+	// Comments without a reason do not disable rules, and are reported.
+	RequireDisableReason bool `json:"require_disable_reason" yaml:"require_disable_reason"`
+
+	// The paths of the proto files to leave out when linting directories or
+	// glob patterns, such as `third_party/**`. Proto files given by name are
+	// always linted.
+	IgnoredPaths []string `json:"ignored_paths" yaml:"ignored_paths"`
 }
 
 // ReadConfigsFromFile reads Configs from a file.
@@ -115,7 +140,7 @@ func (configs Configs) IsRuleEnabled(rule string, path string) bool {
 	// disabled groups. Otherwise, needs to be explicitly enabled.
 	enabled := !matchRule(rule, defaultDisabledRules...)
 	for _, c := range configs {
-		if c.matchPath(path) {
+		if c.MatchesPath(path) {
 			if matchRule(rule, c.DisabledRules...) {
 				enabled = false
 			}
@@ -128,7 +153,86 @@ func (configs Configs) IsRuleEnabled(rule string, path string) bool {
 	return enabled
 }
 
-func (c Config) matchPath(path string) bool {
+// RuleSeverity returns the severity assigned by the configs to the problems
+// of a rule on a file path. Later configs take precedence over earlier ones.
+func (configs Configs) RuleSeverity(rule string, path string) Severity {
+	severity := SeverityError
+	for _, c := range configs {
+		if c.MatchesPath(path) {
+			if s, ok := c.ruleSeverity(rule); ok {
+				severity = s
+			}
+		}
+	}
+	return severity
+}
+
+// RequiresDisableReason returns whether the configs require a reason on the
+// comments that disable rules on a file path.
+func (configs Configs) RequiresDisableReason(path string) bool {
+	for _, c := range configs {
+		if c.MatchesPath(path) && c.RequireDisableReason {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPathIgnored returns true if the configs leave out a file path when
+// linting directories or glob patterns.
+func (configs Configs) IsPathIgnored(path string) bool {
+	for _, c := range configs {
+		if c.MatchesPath(path) && matchPath(path, c.IgnoredPaths...) {
+			return true
+		}
+	}
+	return false
+}
+
+// RuleOptions returns the options set by the configs for a rule on a file
+// path, or nil if there are none.
+func (configs Configs) RuleOptions(rule string, path string) RuleOptions {
+	var options RuleOptions
+	for _, c := range configs {
+		if !c.MatchesPath(path) {
+			continue
+		}
+		for name, opts := range c.RuleOptions {
+			if !strings.EqualFold(name, rule) {
+				continue
+			}
+			if options == nil {
+				options = RuleOptions{}
+			}
+			for k, v := range opts {
+				options[k] = v
+			}
+		}
+	}
+	return options
+}
+
+func (c Config) ruleSeverity(rule string) (Severity, bool) {
+	var match string
+	for prefix := range c.RuleSeverities {
+		if !matchRule(rule, prefix) {
+			continue
+		}
+		// Prefer the most specific pattern, and break ties alphabetically
+		// so that the result does not depend on map iteration order.
+		if len(prefix) > len(match) || (len(prefix) == len(match) && prefix < match) {
+			match = prefix
+		}
+	}
+	if match == "" {
+		return "", false
+	}
+	return c.RuleSeverities[match], true
+}
+
+// MatchesPath returns true if the config applies to a file path: the path
+// matches one of the included paths, if any, and none of the excluded ones.
+func (c Config) MatchesPath(path string) bool {
 	if matchPath(path, c.ExcludedPaths...) {
 		return false
 	}
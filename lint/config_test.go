@@ -246,6 +246,91 @@ func TestRuleConfigs_IsRuleEnabled(t *testing.T) {
 	}
 }
 
+func TestRuleConfigs_RuleSeverity(t *testing.T) {
+	tests := []struct {
+		name    string
+		configs Configs
+		path    string
+		rule    string
+		want    Severity
+	}{
+		{"EmptyConfig", nil, "a.proto", "core::0131::a", SeverityError},
+		{
+			"GroupMatched",
+			Configs{{RuleSeverities: map[string]Severity{"core::0131": SeverityWarning}}},
+			"a.proto",
+			"core::0131::a",
+			SeverityWarning,
+		},
+		{
+			"MostSpecificWins",
+			Configs{{RuleSeverities: map[string]Severity{
+				"core":             SeverityInfo,
+				"core::0131::a":    SeverityError,
+				"core::0131":       SeverityWarning,
+				"client-libraries": SeverityInfo,
+			}}},
+			"a.proto",
+			"core::0131::a",
+			SeverityError,
+		},
+		{
+			"PathNotMatched",
+			Configs{{
+				ExcludedPaths:  []string{"a.proto"},
+				RuleSeverities: map[string]Severity{"core": SeverityInfo},
+			}},
+			"a.proto",
+			"core::0131::a",
+			SeverityError,
+		},
+		{
+			"LaterConfigWins",
+			Configs{
+				{RuleSeverities: map[string]Severity{"core::0131::a": SeverityInfo}},
+				{RuleSeverities: map[string]Severity{"core": SeverityWarning}},
+			},
+			"a.proto",
+			"core::0131::a",
+			SeverityWarning,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.configs.RuleSeverity(test.rule, test.path); got != test.want {
+				t.Errorf("RuleSeverity: got %q, but want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestReadConfigsRuleSeverities(t *testing.T) {
+	want := Configs{{RuleSeverities: map[string]Severity{"core::0131": SeverityWarning}}}
+	t.Run("JSON", func(t *testing.T) {
+		configs, err := ReadConfigsJSON(strings.NewReader(`[{"rule_severities": {"core::0131": "Warning"}}]`))
+		if err != nil {
+			t.Fatalf("ReadConfigsJSON returns error: %v", err)
+		}
+		if !reflect.DeepEqual(configs, want) {
+			t.Errorf("ReadConfigsJSON returns %v, but want %v", configs, want)
+		}
+	})
+	t.Run("YAML", func(t *testing.T) {
+		configs, err := ReadConfigsYAML(strings.NewReader("- rule_severities:\n    core::0131: warning\n"))
+		if err != nil {
+			t.Fatalf("ReadConfigsYAML returns error: %v", err)
+		}
+		if !reflect.DeepEqual(configs, want) {
+			t.Errorf("ReadConfigsYAML returns %v, but want %v", configs, want)
+		}
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		if _, err := ReadConfigsYAML(strings.NewReader("- rule_severities:\n    core::0131: fatal\n")); err == nil {
+			t.Error("ReadConfigsYAML expects an error")
+		}
+	})
+}
+
 type errReader int
 
 func (errReader) Read(p []byte) (int, error) {
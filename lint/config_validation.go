@@ -0,0 +1,316 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigError is an error at a location in a config file.
+type ConfigError struct {
+	Path    string
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *ConfigError) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s", e.Path, e.Line, e.Column, e.Message)
+}
+
+// ConfigErrors are the errors found in a config file, in order.
+type ConfigErrors []*ConfigError
+
+func (e ConfigErrors) Error() string {
+	var lines []string
+	for _, err := range e {
+		lines = append(lines, err.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// linterRuleNames are the names of the problems reported by the linter
+// itself, which the configs may refer to like rules.
+var linterRuleNames = []RuleName{
+	UnusedDisableName,
+	UnknownDisableName,
+	ExpiredDisableName,
+	InvalidDisableName,
+}
+
+// ValidateConfigFile checks a config file (JSON or YAML) against the rules:
+// it reports the unknown fields, the rule names and groups that match no
+// rule, the options of unknown rules or that the rules reject, and the
+// invalid path patterns. The errors are returned as ConfigErrors.
+func ValidateConfigFile(path string, rules RuleRegistry) error {
+	return validateConfigFile(path, rules, true)
+}
+
+// ValidateConfigFileFields checks a config file like ValidateConfigFile, but
+// only reports the errors that do not depend on the rules: the unknown
+// fields and the invalid path patterns. It lets a config be checked before
+// the rules that it defines, such as its custom rules, are built.
+func ValidateConfigFileFields(path string) error {
+	return validateConfigFile(path, nil, false)
+}
+
+func validateConfigFile(path string, rules RuleRegistry, checkRules bool) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if filepath.Ext(path) == ".json" {
+		// JSON is parsed as YAML, for the locations of the nodes. Tabs are
+		// only valid between tokens in JSON, but are not in YAML.
+		b = bytes.ReplaceAll(b, []byte("\t"), []byte(" "))
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	v := &configValidator{path: path, rules: rules, checkRules: checkRules}
+	if len(doc.Content) > 0 {
+		v.checkFields(doc.Content[0], reflect.TypeOf(Configs{}))
+		v.checkConfigs(doc.Content[0])
+	}
+	if len(v.errs) > 0 {
+		return v.errs
+	}
+	return nil
+}
+
+type configValidator struct {
+	path  string
+	rules RuleRegistry
+	// checkRules is whether the rule names and options are checked.
+	checkRules bool
+	errs       ConfigErrors
+}
+
+func (v *configValidator) errorf(n *yaml.Node, format string, args ...interface{}) {
+	v.errs = append(v.errs, &ConfigError{Path: v.path, Line: n.Line, Column: n.Column, Message: fmt.Sprintf(format, args...)})
+}
+
+// checkFields reports the keys of the mappings of a node that are not fields
+// of the type that the node is decoded into. Type mismatches are left to the
+// decoder.
+func (v *configValidator) checkFields(n *yaml.Node, t reflect.Type) {
+	if n.Kind == yaml.AliasNode {
+		n = n.Alias
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		v.checkFields(n, t.Elem())
+	case reflect.Slice:
+		if n.Kind == yaml.SequenceNode {
+			for _, item := range n.Content {
+				v.checkFields(item, t.Elem())
+			}
+		}
+	case reflect.Map:
+		if n.Kind == yaml.MappingNode {
+			for i := 1; i < len(n.Content); i += 2 {
+				v.checkFields(n.Content[i], t.Elem())
+			}
+		}
+	case reflect.Struct:
+		if n.Kind != yaml.MappingNode {
+			return
+		}
+		fields := map[string]reflect.Type{}
+		var names []string
+		for i := 0; i < t.NumField(); i++ {
+			name := strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0]
+			if name != "" && name != "-" {
+				fields[name] = t.Field(i).Type
+				names = append(names, name)
+			}
+		}
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			key := n.Content[i]
+			ft, ok := fields[key.Value]
+			if !ok {
+				v.errorf(key, "unknown field %q%s", key.Value, didYouMean(key.Value, names))
+				continue
+			}
+			v.checkFields(n.Content[i+1], ft)
+		}
+	}
+}
+
+// checkConfigs checks the values of the configs.
+func (v *configValidator) checkConfigs(n *yaml.Node) {
+	if n.Kind != yaml.SequenceNode {
+		return
+	}
+	for _, config := range n.Content {
+		if config.Kind != yaml.MappingNode {
+			continue
+		}
+		for i := 0; i+1 < len(config.Content); i += 2 {
+			value := config.Content[i+1]
+			switch config.Content[i].Value {
+			case "included_paths", "excluded_paths", "ignored_paths":
+				for _, item := range scalars(value) {
+					if !doublestar.ValidatePattern(filepath.ToSlash(item.Value)) {
+						v.errorf(item, "invalid path pattern %q", item.Value)
+					}
+				}
+			case "enabled_rules", "disabled_rules":
+				if !v.checkRules {
+					continue
+				}
+				for _, item := range scalars(value) {
+					v.checkRulePattern(item, item.Value)
+				}
+			case "rule_severities":
+				if !v.checkRules {
+					continue
+				}
+				for _, key := range mappingKeys(value) {
+					v.checkRulePattern(key, key.Value)
+				}
+			case "rule_options":
+				if !v.checkRules || value.Kind != yaml.MappingNode {
+					continue
+				}
+				for j := 0; j+1 < len(value.Content); j += 2 {
+					v.checkRuleOptions(value.Content[j], value.Content[j+1])
+				}
+			}
+		}
+	}
+}
+
+// checkRulePattern reports a rule name or group that matches no rule.
+func (v *configValidator) checkRulePattern(n *yaml.Node, pattern string) {
+	names := v.ruleNames()
+	for _, name := range names {
+		if matchRule(name, pattern) || (aliasMap[name] != "" && matchRule(aliasMap[name], pattern)) {
+			return
+		}
+	}
+	v.errorf(n, "%q does not match any rule%s", pattern, didYouMean(pattern, names))
+}
+
+// checkRuleOptions reports the options of an unknown rule, or that the rule
+// rejects.
+func (v *configValidator) checkRuleOptions(key, value *yaml.Node) {
+	var rule ProtoRule
+	for name, r := range v.rules {
+		if strings.EqualFold(string(name), key.Value) {
+			rule = r
+		}
+	}
+	if rule == nil {
+		v.errorf(key, "unknown rule %q%s", key.Value, didYouMean(key.Value, v.ruleNames()))
+		return
+	}
+	cr, ok := rule.(ConfigurableRule)
+	if !ok {
+		v.errorf(key, "rule %q does not have options", rule.GetName())
+		return
+	}
+	var options RuleOptions
+	if err := value.Decode(&options); err != nil {
+		return
+	}
+	if _, err := cr.WithOptions(options); err != nil {
+		v.errorf(value, "%v", err)
+	}
+}
+
+// ruleNames returns the names of the rules, and of the problems reported by
+// the linter itself, sorted.
+func (v *configValidator) ruleNames() []string {
+	var names []string
+	for name := range v.rules {
+		names = append(names, string(name))
+	}
+	for _, name := range linterRuleNames {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// scalars returns the scalar items of a sequence node.
+func scalars(n *yaml.Node) []*yaml.Node {
+	var items []*yaml.Node
+	if n.Kind == yaml.SequenceNode {
+		for _, item := range n.Content {
+			if item.Kind == yaml.ScalarNode {
+				items = append(items, item)
+			}
+		}
+	}
+	return items
+}
+
+// mappingKeys returns the keys of a mapping node.
+func mappingKeys(n *yaml.Node) []*yaml.Node {
+	var keys []*yaml.Node
+	if n.Kind == yaml.MappingNode {
+		for i := 0; i < len(n.Content); i += 2 {
+			keys = append(keys, n.Content[i])
+		}
+	}
+	return keys
+}
+
+// didYouMean returns a suggestion of the closest candidate to s, if one is
+// close enough to be a typo, or "".
+func didYouMean(s string, candidates []string) string {
+	best, bestDistance := "", len(s)/3+1
+	for _, c := range candidates {
+		if d := editDistance(strings.ToLower(s), strings.ToLower(c)); d < bestDistance {
+			best, bestDistance = c, d
+		}
+	}
+	if best == "" {
+		return ""
+	}
+	return fmt.Sprintf(", did you mean %q?", best)
+}
+
+// editDistance returns the Levenshtein distance between two strings.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestValidateConfigFile(t *testing.T) {
+	var built int
+	rules := NewRuleRegistry()
+	err := rules.Register(111,
+		&MethodRule{Name: NewRuleName(111, "http-method"), LintMethod: func(protoreflect.MethodDescriptor) []Problem { return nil }},
+		newTestOptionsRule(&built),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name    string
+		file    string
+		content string
+		want    []string
+	}{
+		{
+			name: "ValidYAML",
+			file: "config.yaml",
+			content: `
+- included_paths: ['a/**/*.proto']
+  enabled_rules: [core, 'core::0111', 'core::0111::http-method', all]
+  disabled_rules: ['http-method', 'api-linter::unused-disable']
+  rule_severities: {'core::0111': warning}
+  rule_options:
+    core::0111::test-rule: {words: [b], limit: 2}
+  require_disable_reason: true
+`,
+		},
+		{
+			name:    "ValidJSON",
+			file:    "config.json",
+			content: "[\n\t{\n\t\t\"disabled_rules\": [\"core::0111\"]\n\t}\n]",
+		},
+		{
+			name: "Invalid",
+			file: "config.yaml",
+			content: `- exclude_paths: ['a/**']
+  included_paths: ['a/[b']
+  disabled_rules: ['core::0111::http-methd', 'core::0112']
+  rule_severities: {'cloud': info}
+  rule_options:
+    core::0111::test-rul: {}
+    core::0111::http-method: {}
+    core::0111::test-rule: {unknown: 1}
+  custom_rules:
+    - name: test
+      assertion: {}
+`,
+			want: []string{
+				`config.yaml:1:3: unknown field "exclude_paths", did you mean "excluded_paths"?`,
+				`config.yaml:11:7: unknown field "assertion", did you mean "assert"?`,
+				`config.yaml:2:20: invalid path pattern "a/[b"`,
+				`config.yaml:3:20: "core::0111::http-methd" does not match any rule, did you mean "core::0111::http-method"?`,
+				`config.yaml:3:46: "core::0112" does not match any rule`,
+				`config.yaml:4:21: "cloud" does not match any rule`,
+				`config.yaml:6:5: unknown rule "core::0111::test-rul", did you mean "core::0111::test-rule"?`,
+				`config.yaml:7:5: rule "core::0111::http-method" does not have options`,
+				`config.yaml:8:28: invalid options for rule "core::0111::test-rule": json: unknown field "unknown"`,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), test.file)
+			if err := os.WriteFile(path, []byte(test.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			if err := ValidateConfigFile(path, rules); err != nil {
+				var errs ConfigErrors
+				if !errors.As(err, &errs) {
+					t.Fatalf("Got error %v, expected ConfigErrors.", err)
+				}
+				for _, e := range errs {
+					got = append(got, test.file+e.Error()[len(path):])
+				}
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Got %q, expected %q.", got, test.want)
+			}
+		})
+	}
+}
+
+func TestValidateConfigFileFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	content := `- exclude_paths: ['a/**']
+  included_paths: ['a/[b']
+  disabled_rules: ['core::0112']
+  rule_options:
+    core::0111::test-rule: {}
+`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// The rule names and options are not checked.
+	var got []string
+	var errs ConfigErrors
+	if err := ValidateConfigFileFields(path); !errors.As(err, &errs) {
+		t.Fatalf("Got error %v, expected ConfigErrors.", err)
+	}
+	for _, e := range errs {
+		got = append(got, "config.yaml"+e.Error()[len(path):])
+	}
+	want := []string{
+		`config.yaml:1:3: unknown field "exclude_paths", did you mean "excluded_paths"?`,
+		`config.yaml:2:20: invalid path pattern "a/[b"`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %q, expected %q.", got, want)
+	}
+}
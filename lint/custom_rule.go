@@ -0,0 +1,330 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// CustomRule is a rule defined in a config: it checks that the descriptors of
+// a kind that match a condition also match an assertion.
+type CustomRule struct {
+	// The name of the rule, such as `request-suffix`. The full name of the
+	// rule is `custom::request-suffix`.
+	Name string `json:"name" yaml:"name"`
+
+	// The kind of descriptors to check: `message`, `field`, `method`,
+	// `enum`, `enum_value` or `service`.
+	Kind string `json:"kind" yaml:"kind"`
+
+	// A short description of the rule. Optional.
+	Description string `json:"description" yaml:"description"`
+
+	// The condition that a descriptor must match to be checked. Optional; if
+	// omitted, every descriptor of the kind is checked.
+	OnlyIf *CustomRuleCondition `json:"only_if" yaml:"only_if"`
+
+	// The condition that the checked descriptors must match.
+	Assert CustomRuleCondition `json:"assert" yaml:"assert"`
+
+	// The message of the problems reported on the descriptors that do not
+	// match the assertion.
+	Message string `json:"message" yaml:"message"`
+}
+
+// CustomRuleCondition is a condition on a descriptor. A descriptor matches the
+// condition if it matches every predicate that is set.
+type CustomRuleCondition struct {
+	// A regular expression that the name of the descriptor must match, such
+	// as `^[A-Z][A-Za-z0-9]*Request$`.
+	Name string `json:"name" yaml:"name"`
+
+	// An option that must be set on the descriptor, such as `deprecated` or
+	// `google.api.resource`. Extensions are given by their full name.
+	Option string `json:"option" yaml:"option"`
+
+	// The type of a field: a scalar type, such as `string`, or the full
+	// name of a message or enum, such as `google.protobuf.Timestamp`. Only
+	// valid for fields.
+	Type string `json:"type" yaml:"type"`
+
+	// A [CEL](https://cel.dev) expression that must evaluate to true, such
+	// as `descriptor.options.deprecated`. The expression can use `name`, the
+	// name of the descriptor, `full_name`, its full name, and `descriptor`,
+	// its descriptor proto, such as a `google.protobuf.DescriptorProto` for
+	// a message.
+	CEL string `json:"cel" yaml:"cel"`
+}
+
+// CustomRules returns the rules defined in the configs, or an error if one of
+// them is not valid.
+func (configs Configs) CustomRules() ([]ProtoRule, error) {
+	var rules []ProtoRule
+	for _, c := range configs {
+		for _, r := range c.CustomRules {
+			rule, err := r.rule(c)
+			if err != nil {
+				return nil, fmt.Errorf("custom rule %q: %w", r.Name, err)
+			}
+			rules = append(rules, rule)
+		}
+	}
+	return rules, nil
+}
+
+// rule compiles the custom rule into a rule that only runs on the paths
+// matched by the config.
+func (r CustomRule) rule(c Config) (ProtoRule, error) {
+	name := RuleName(CustomRuleGroup + nameSeparator + r.Name)
+	if r.Name == "" || !name.IsValid() {
+		return nil, errors.New("the name must be lowercase letters, digits and dashes")
+	}
+	if r.Message == "" {
+		return nil, errors.New("missing message")
+	}
+	var onlyIf func(protoreflect.Descriptor) bool
+	if r.OnlyIf != nil {
+		var err error
+		if onlyIf, err = r.OnlyIf.compile(r.Kind); err != nil {
+			return nil, fmt.Errorf("only_if: %w", err)
+		}
+	}
+	if r.Assert == (CustomRuleCondition{}) {
+		return nil, errors.New("missing assertion")
+	}
+	assert, err := r.Assert.compile(r.Kind)
+	if err != nil {
+		return nil, fmt.Errorf("assert: %w", err)
+	}
+
+	check := func(d protoreflect.Descriptor) bool {
+		return c.MatchesPath(d.ParentFile().Path()) && (onlyIf == nil || onlyIf(d))
+	}
+	lint := func(d protoreflect.Descriptor) []Problem {
+		if assert(d) {
+			return nil
+		}
+		return []Problem{{Message: r.Message, Descriptor: d}}
+	}
+	description := r.Description
+	if description == "" {
+		description = r.Message
+	}
+
+	switch r.Kind {
+	case "message":
+		return &MessageRule{
+			Name: name,
+			RuleInfo: RuleInfo{
+				Description: description,
+			},
+			OnlyIf:      func(m protoreflect.MessageDescriptor) bool { return check(m) },
+			LintMessage: func(m protoreflect.MessageDescriptor) []Problem { return lint(m) },
+		}, nil
+	case "field":
+		return &FieldRule{
+			Name: name,
+			RuleInfo: RuleInfo{
+				Description: description,
+			},
+			OnlyIf:    func(f protoreflect.FieldDescriptor) bool { return check(f) },
+			LintField: func(f protoreflect.FieldDescriptor) []Problem { return lint(f) },
+		}, nil
+	case "method":
+		return &MethodRule{
+			Name: name,
+			RuleInfo: RuleInfo{
+				Description: description,
+			},
+			OnlyIf:     func(m protoreflect.MethodDescriptor) bool { return check(m) },
+			LintMethod: func(m protoreflect.MethodDescriptor) []Problem { return lint(m) },
+		}, nil
+	case "enum":
+		return &EnumRule{
+			Name: name,
+			RuleInfo: RuleInfo{
+				Description: description,
+			},
+			OnlyIf:   func(e protoreflect.EnumDescriptor) bool { return check(e) },
+			LintEnum: func(e protoreflect.EnumDescriptor) []Problem { return lint(e) },
+		}, nil
+	case "enum_value":
+		return &EnumValueRule{
+			Name: name,
+			RuleInfo: RuleInfo{
+				Description: description,
+			},
+			OnlyIf:        func(v protoreflect.EnumValueDescriptor) bool { return check(v) },
+			LintEnumValue: func(v protoreflect.EnumValueDescriptor) []Problem { return lint(v) },
+		}, nil
+	case "service":
+		return &ServiceRule{
+			Name: name,
+			RuleInfo: RuleInfo{
+				Description: description,
+			},
+			OnlyIf:      func(s protoreflect.ServiceDescriptor) bool { return check(s) },
+			LintService: func(s protoreflect.ServiceDescriptor) []Problem { return lint(s) },
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown kind %q", r.Kind)
+}
+
+// compile returns a function that reports whether a descriptor of the given
+// kind matches the condition.
+func (cond CustomRuleCondition) compile(kind string) (func(protoreflect.Descriptor) bool, error) {
+	var checks []func(protoreflect.Descriptor) bool
+	if cond.Name != "" {
+		re, err := regexp.Compile(cond.Name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid name: %w", err)
+		}
+		checks = append(checks, func(d protoreflect.Descriptor) bool {
+			return re.MatchString(string(d.Name()))
+		})
+	}
+	if cond.Option != "" {
+		option := strings.TrimSuffix(strings.TrimPrefix(cond.Option, "("), ")")
+		checks = append(checks, func(d protoreflect.Descriptor) bool {
+			return hasOption(d, option)
+		})
+	}
+	if cond.Type != "" {
+		if kind != "field" {
+			return nil, errors.New("type is only valid for fields")
+		}
+		typ := strings.TrimPrefix(cond.Type, ".")
+		checks = append(checks, func(d protoreflect.Descriptor) bool {
+			return fieldType(d.(protoreflect.FieldDescriptor)) == typ
+		})
+	}
+	if cond.CEL != "" {
+		program, err := compileCEL(cond.CEL, kind)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, program)
+	}
+	return func(d protoreflect.Descriptor) bool {
+		for _, check := range checks {
+			if !check(d) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+// celDescriptors maps the kinds of custom rules to the type of their
+// descriptor proto and the function that builds it.
+var celDescriptors = map[string]struct {
+	typ     proto.Message
+	toProto func(protoreflect.Descriptor) proto.Message
+}{
+	"message": {&dpb.DescriptorProto{}, func(d protoreflect.Descriptor) proto.Message {
+		return protodesc.ToDescriptorProto(d.(protoreflect.MessageDescriptor))
+	}},
+	"field": {&dpb.FieldDescriptorProto{}, func(d protoreflect.Descriptor) proto.Message {
+		return protodesc.ToFieldDescriptorProto(d.(protoreflect.FieldDescriptor))
+	}},
+	"method": {&dpb.MethodDescriptorProto{}, func(d protoreflect.Descriptor) proto.Message {
+		return protodesc.ToMethodDescriptorProto(d.(protoreflect.MethodDescriptor))
+	}},
+	"enum": {&dpb.EnumDescriptorProto{}, func(d protoreflect.Descriptor) proto.Message {
+		return protodesc.ToEnumDescriptorProto(d.(protoreflect.EnumDescriptor))
+	}},
+	"enum_value": {&dpb.EnumValueDescriptorProto{}, func(d protoreflect.Descriptor) proto.Message {
+		return protodesc.ToEnumValueDescriptorProto(d.(protoreflect.EnumValueDescriptor))
+	}},
+	"service": {&dpb.ServiceDescriptorProto{}, func(d protoreflect.Descriptor) proto.Message {
+		return protodesc.ToServiceDescriptorProto(d.(protoreflect.ServiceDescriptor))
+	}},
+}
+
+// compileCEL returns a function that reports whether a descriptor of the
+// given kind makes a CEL expression evaluate to true. An expression that
+// fails to evaluate on a descriptor, such as one that reads a missing map
+// key, does not match it.
+func compileCEL(expr, kind string) (func(protoreflect.Descriptor) bool, error) {
+	desc, ok := celDescriptors[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown kind %q", kind)
+	}
+	env, err := cel.NewEnv(
+		cel.Types(desc.typ),
+		cel.Variable("name", cel.StringType),
+		cel.Variable("full_name", cel.StringType),
+		cel.Variable("descriptor", cel.ObjectType(string(desc.typ.ProtoReflect().Descriptor().FullName()))),
+	)
+	if err != nil {
+		return nil, err
+	}
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, fmt.Errorf("invalid cel: %w", iss.Err())
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, fmt.Errorf("invalid cel: the expression must be a bool, not %s", ast.OutputType())
+	}
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cel: %w", err)
+	}
+	return func(d protoreflect.Descriptor) bool {
+		out, _, err := program.Eval(map[string]any{
+			"name":       string(d.Name()),
+			"full_name":  string(d.FullName()),
+			"descriptor": desc.toProto(d),
+		})
+		return err == nil && out == types.True
+	}, nil
+}
+
+// hasOption returns whether an option is set on a descriptor. Extensions
+// match their full name, and the other options match their name.
+func hasOption(d protoreflect.Descriptor, option string) bool {
+	found := false
+	d.Options().ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if fd.IsExtension() {
+			found = string(fd.FullName()) == option
+		} else {
+			found = string(fd.Name()) == option
+		}
+		return !found
+	})
+	return found
+}
+
+// fieldType returns the full name of the message or enum type of a field,
+// or the name of its scalar type.
+func fieldType(f protoreflect.FieldDescriptor) string {
+	switch {
+	case f.Message() != nil:
+		return string(f.Message().FullName())
+	case f.Enum() != nil:
+		return string(f.Enum().FullName())
+	}
+	return f.Kind().String()
+}
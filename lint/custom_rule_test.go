@@ -0,0 +1,159 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestConfigs_CustomRules(t *testing.T) {
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:   proto.String("a/test.proto"),
+		Syntax: proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("GetBookRequest"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: proto.String("name"), Number: proto.Int32(1), Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(), JsonName: proto.String("name")},
+					{Name: proto.String("count"), Number: proto.Int32(2), Type: descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum(), JsonName: proto.String("count")},
+				},
+			},
+			{
+				Name:    proto.String("Book"),
+				Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(false)},
+			},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("Failed to build the file descriptor: %v", err)
+	}
+
+	tests := []struct {
+		testName string
+		config   Config
+		want     []string
+	}{
+		{
+			"Name",
+			Config{CustomRules: []CustomRule{{Name: "suffix", Kind: "message", Assert: CustomRuleCondition{Name: "Request$"}, Message: "m"}}},
+			[]string{"Book"},
+		},
+		{
+			"OnlyIf",
+			Config{CustomRules: []CustomRule{{Name: "suffix", Kind: "message", OnlyIf: &CustomRuleCondition{Name: "^Get"}, Assert: CustomRuleCondition{Name: "Request$"}, Message: "m"}}},
+			nil,
+		},
+		{
+			"Option",
+			Config{CustomRules: []CustomRule{{Name: "option", Kind: "message", Assert: CustomRuleCondition{Option: "map_entry"}, Message: "m"}}},
+			[]string{"GetBookRequest"},
+		},
+		{
+			"Type",
+			Config{CustomRules: []CustomRule{{Name: "type", Kind: "field", OnlyIf: &CustomRuleCondition{Type: "int32"}, Assert: CustomRuleCondition{Name: "_count$"}, Message: "m"}}},
+			[]string{"count"},
+		},
+		{
+			"CEL",
+			Config{CustomRules: []CustomRule{{Name: "cel", Kind: "message", Assert: CustomRuleCondition{CEL: "size(descriptor.field) > 0"}, Message: "m"}}},
+			[]string{"Book"},
+		},
+		{
+			"CELName",
+			Config{CustomRules: []CustomRule{{Name: "cel", Kind: "field", OnlyIf: &CustomRuleCondition{CEL: `full_name.startsWith("GetBookRequest.")`}, Assert: CustomRuleCondition{CEL: "descriptor.type == 9"}, Message: "m"}}},
+			[]string{"count"},
+		},
+		{
+			"ExcludedPath",
+			Config{ExcludedPaths: []string{"a/**"}, CustomRules: []CustomRule{{Name: "suffix", Kind: "message", Assert: CustomRuleCondition{Name: "Request$"}, Message: "m"}}},
+			nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			rules, err := Configs{test.config}.CustomRules()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(rules) != 1 {
+				t.Fatalf("Got %d rules, expected 1.", len(rules))
+			}
+			if got, want := rules[0].GetName(), RuleName("custom::"+test.config.CustomRules[0].Name); got != want {
+				t.Errorf("Got name %q, expected %q.", got, want)
+			}
+			var got []string
+			for _, p := range rules[0].Lint(fd) {
+				got = append(got, string(p.Descriptor.Name()))
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Got %v, expected %v.", got, test.want)
+			}
+		})
+	}
+}
+
+func TestConfigs_CustomRulesErrors(t *testing.T) {
+	valid := CustomRule{Name: "rule", Kind: "message", Assert: CustomRuleCondition{Name: "^A"}, Message: "m"}
+	tests := []struct {
+		testName string
+		modify   func(r *CustomRule)
+		want     string
+	}{
+		{"Name", func(r *CustomRule) { r.Name = "Bad Name" }, "the name must be"},
+		{"Kind", func(r *CustomRule) { r.Kind = "file" }, `unknown kind "file"`},
+		{"Message", func(r *CustomRule) { r.Message = "" }, "missing message"},
+		{"Assert", func(r *CustomRule) { r.Assert = CustomRuleCondition{} }, "missing assertion"},
+		{"Regexp", func(r *CustomRule) { r.OnlyIf = &CustomRuleCondition{Name: "("} }, "only_if: invalid name"},
+		{"Type", func(r *CustomRule) { r.Assert.Type = "string" }, "assert: type is only valid for fields"},
+		{"CELSyntax", func(r *CustomRule) { r.Assert.CEL = "name ==" }, "assert: invalid cel"},
+		{"CELType", func(r *CustomRule) { r.Assert.CEL = "name" }, "assert: invalid cel: the expression must be a bool"},
+		{"CELField", func(r *CustomRule) { r.Assert.CEL = "descriptor.number == 1" }, "assert: invalid cel"},
+	}
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			r := valid
+			test.modify(&r)
+			_, err := Configs{{CustomRules: []CustomRule{r}}}.CustomRules()
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("Got error %v, expected it to contain %q.", err, test.want)
+			}
+		})
+	}
+}
+
+func TestRuleRegistry_RegisterCustom(t *testing.T) {
+	rules, err := Configs{{CustomRules: []CustomRule{
+		{Name: "rule", Kind: "message", Assert: CustomRuleCondition{Name: "^A"}, Message: "m"},
+	}}}.CustomRules()
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry := NewRuleRegistry()
+	if err := registry.RegisterCustom(rules...); err != nil {
+		t.Fatal(err)
+	}
+	if err := registry.RegisterCustom(rules...); err == nil || !strings.Contains(err.Error(), "duplicate rule name") {
+		t.Errorf("Got error %v, expected a duplicate rule name.", err)
+	}
+	if err := registry.RegisterCustom(&MessageRule{Name: NewRuleName(111, "test")}); err == nil {
+		t.Errorf("Expected an error for a rule outside of the custom group.")
+	}
+}
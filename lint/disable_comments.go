@@ -0,0 +1,258 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// The names of the problems reported about disable comments. Like the names
+// of rules, they can be given a severity or disabled in the configs.
+const (
+	// UnusedDisableName is the name of the problems about disable comments
+	// that did not suppress any problem, reported with ReportUnusedDisables.
+	UnusedDisableName RuleName = "api-linter::unused-disable"
+
+	// UnknownDisableName is the name of the problems about disable comments
+	// that name unknown rules, reported with ReportUnusedDisables.
+	UnknownDisableName RuleName = "api-linter::unknown-disable"
+
+	// ExpiredDisableName is the name of the problems about disable comments
+	// past their `until` date.
+	ExpiredDisableName RuleName = "api-linter::expired-disable"
+
+	// InvalidDisableName is the name of the problems about disable comments
+	// that cannot be parsed, or that lack a reason required by the configs.
+	InvalidDisableName RuleName = "api-linter::invalid-disable"
+)
+
+// disableDateFormat is the format of the `until` dates of disable comments.
+const disableDateFormat = "2006-01-02"
+
+var (
+	disableDirectiveRegex = regexp.MustCompile(`api-linter:\s*([^\s=]+)\s*=\s*disabled\b(.*)`)
+	disableAttrRegex      = regexp.MustCompile(`(?:^|\s)(reason|until)=("[^"]*"|[^\s"]*)`)
+)
+
+// disableDirective is a directive that disables a rule, such as
+// `api-linter: core::0140::lower-snake=disabled reason="legacy" until=2027-01-01`.
+type disableDirective struct {
+	// rule is the rule as named in the directive, which may also be a group
+	// of rules.
+	rule string
+
+	// reason is the justification of the directive, if any.
+	reason string
+
+	// until is the date on which the directive stops applying, or the zero
+	// time if it does not expire.
+	until time.Time
+
+	// err describes why the directive is invalid, if it is.
+	err string
+}
+
+// parseDisableDirective parses the directive in a comment line, if any. Only
+// the `reason` and `until` attributes are interpreted; any other text after
+// the directive is ignored, as older versions of the linter did.
+func parseDisableDirective(commentLine string) (disableDirective, bool) {
+	match := disableDirectiveRegex.FindStringSubmatch(commentLine)
+	if match == nil {
+		return disableDirective{}, false
+	}
+	directive := disableDirective{rule: match[1]}
+	for _, attr := range disableAttrRegex.FindAllStringSubmatch(match[2], -1) {
+		key, value := attr[1], strings.Trim(attr[2], `"`)
+		switch key {
+		case "reason":
+			directive.reason = value
+		case "until":
+			until, err := time.Parse(disableDateFormat, value)
+			if err != nil {
+				directive.err = fmt.Sprintf("invalid date %q, expected YYYY-MM-DD", value)
+			}
+			directive.until = until
+		}
+	}
+	return directive, true
+}
+
+// expired returns whether the directive is past its `until` date.
+func (d disableDirective) expired(now time.Time) bool {
+	return !d.until.IsZero() && !now.Before(d.until)
+}
+
+// disablePolicy determines which disable directives apply.
+type disablePolicy struct {
+	now           time.Time
+	requireReason bool
+}
+
+// applies returns whether a directive disables rules under the policy.
+func (p disablePolicy) applies(d disableDirective) bool {
+	return d.err == "" && !d.expired(p.now) && (d.reason != "" || !p.requireReason)
+}
+
+// disablePolicy returns the policy of the disable comments of a file.
+func (l *Linter) disablePolicy(path string) disablePolicy {
+	return disablePolicy{now: time.Now(), requireReason: l.configs.RequiresDisableReason(path)}
+}
+
+// disableComment is a comment that disables a rule on a descriptor, such as
+// `(-- api-linter: core::0140::lower-snake=disabled --)`. Comments in the
+// file header disable rules on the file descriptor.
+type disableComment struct {
+	descriptor protoreflect.Descriptor
+	disableDirective
+}
+
+// ReportUnusedDisables is a LinterOption for setting if the linter reports
+// the disable comments that did not suppress any problem of a rule that ran
+// on the file, and the disable comments that name unknown rules.
+func ReportUnusedDisables(reportUnusedDisables bool) LinterOption {
+	return func(l *Linter) {
+		l.reportUnusedDisables = reportUnusedDisables
+	}
+}
+
+// disableCommentProblems returns the problems about the disable comments of
+// a file, given the results of the rules, in the order of l.ruleNames().
+func (l *Linter) disableCommentProblems(fd protoreflect.FileDescriptor, results []ruleResult) []Problem {
+	names := l.ruleNames()
+	policy := l.disablePolicy(fd.Path())
+	used := map[disableComment]bool{}
+	for _, r := range results {
+		for _, c := range r.usedDisables {
+			used[c] = true
+		}
+	}
+
+	var problems []Problem
+	add := func(name RuleName, c disableComment, msg string) {
+		if !l.configs.IsRuleEnabled(string(name), fd.Path()) {
+			return
+		}
+		problems = append(problems, Problem{
+			Message:    msg,
+			Descriptor: c.descriptor,
+			RuleID:     name,
+			Severity:   l.configs.RuleSeverity(string(name), fd.Path()),
+		})
+	}
+	for _, c := range disableComments(fd) {
+		known, ran := false, false
+		for i, name := range names {
+			if matchRule(string(name), c.rule) || (aliasMap[string(name)] != "" && matchRule(aliasMap[string(name)], c.rule)) {
+				known = true
+				ran = ran || results[i].ran
+			}
+		}
+		switch {
+		case c.err != "":
+			add(InvalidDisableName, c, fmt.Sprintf("Disable comment for %q on %q is invalid: %s.", c.rule, descriptorName(c.descriptor), c.err))
+		case c.reason == "" && policy.requireReason:
+			add(InvalidDisableName, c, fmt.Sprintf("Disable comment for %q on %q has no reason, which the config requires.", c.rule, descriptorName(c.descriptor)))
+		case c.expired(policy.now):
+			add(ExpiredDisableName, c, fmt.Sprintf("Disable comment for %q on %q expired on %s.", c.rule, descriptorName(c.descriptor), c.until.Format(disableDateFormat)))
+		case !l.reportUnusedDisables:
+		case !known:
+			add(UnknownDisableName, c, fmt.Sprintf("Disable comment on %q names the unknown rule %q.", descriptorName(c.descriptor), c.rule))
+		case ran && !used[c] && !l.ignoreCommentDisables:
+			add(UnusedDisableName, c, fmt.Sprintf("Disable comment for %q on %q did not suppress any problem.", c.rule, descriptorName(c.descriptor)))
+		}
+	}
+	return problems
+}
+
+// disableComments returns the disable comments of a file, in the order of
+// the descriptors.
+func disableComments(fd protoreflect.FileDescriptor) []disableComment {
+	var comments []disableComment
+	seen := map[disableComment]bool{}
+	walkDescriptors(fd, func(d protoreflect.Descriptor) {
+		for _, line := range descriptorCommentLines(d) {
+			if directive, ok := parseDisableDirective(line); ok {
+				c := disableComment{descriptor: d, disableDirective: directive}
+				if !seen[c] {
+					seen[c] = true
+					comments = append(comments, c)
+				}
+			}
+		}
+	})
+	return comments
+}
+
+// walkDescriptors calls f for the file and every descriptor in it.
+func walkDescriptors(fd protoreflect.FileDescriptor, f func(protoreflect.Descriptor)) {
+	f(fd)
+	var walkMessage func(m protoreflect.MessageDescriptor)
+	walkEnum := func(e protoreflect.EnumDescriptor) {
+		f(e)
+		for i := 0; i < e.Values().Len(); i++ {
+			f(e.Values().Get(i))
+		}
+	}
+	walkMessage = func(m protoreflect.MessageDescriptor) {
+		f(m)
+		for i := 0; i < m.Fields().Len(); i++ {
+			f(m.Fields().Get(i))
+		}
+		for i := 0; i < m.Oneofs().Len(); i++ {
+			f(m.Oneofs().Get(i))
+		}
+		for i := 0; i < m.Extensions().Len(); i++ {
+			f(m.Extensions().Get(i))
+		}
+		for i := 0; i < m.Enums().Len(); i++ {
+			walkEnum(m.Enums().Get(i))
+		}
+		for i := 0; i < m.Messages().Len(); i++ {
+			if !m.Messages().Get(i).IsMapEntry() {
+				walkMessage(m.Messages().Get(i))
+			}
+		}
+	}
+	for i := 0; i < fd.Messages().Len(); i++ {
+		walkMessage(fd.Messages().Get(i))
+	}
+	for i := 0; i < fd.Enums().Len(); i++ {
+		walkEnum(fd.Enums().Get(i))
+	}
+	for i := 0; i < fd.Extensions().Len(); i++ {
+		f(fd.Extensions().Get(i))
+	}
+	for i := 0; i < fd.Services().Len(); i++ {
+		s := fd.Services().Get(i)
+		f(s)
+		for j := 0; j < s.Methods().Len(); j++ {
+			f(s.Methods().Get(j))
+		}
+	}
+}
+
+// descriptorName returns the name of a descriptor for messages: its full
+// name, or the path of a file.
+func descriptorName(d protoreflect.Descriptor) string {
+	if f, ok := d.(protoreflect.FileDescriptor); ok {
+		return f.Path()
+	}
+	return string(d.FullName())
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseDisableDirective(t *testing.T) {
+	date := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		name string
+		line string
+		want disableDirective
+		ok   bool
+	}{
+		{"NoDirective", "A regular comment.", disableDirective{}, false},
+		{"Rule", "(-- api-linter: core::0140::lower-snake=disabled --)", disableDirective{rule: "core::0140::lower-snake"}, true},
+		{"Spaces", "api-linter: core::0140 = disabled", disableDirective{rule: "core::0140"}, true},
+		{"Reason", `(-- api-linter: core::0140=disabled reason="legacy field" --)`, disableDirective{rule: "core::0140", reason: "legacy field"}, true},
+		{"Until", "api-linter: core::0140=disabled until=2027-01-01", disableDirective{rule: "core::0140", until: date}, true},
+		{
+			"ReasonAndUntil",
+			`api-linter: core::0140::abbreviations=disabled reason="legacy field" until=2027-01-01`,
+			disableDirective{rule: "core::0140::abbreviations", reason: "legacy field", until: date},
+			true,
+		},
+		{"InvalidDate", "api-linter: core::0140=disabled until=tomorrow", disableDirective{rule: "core::0140", err: `invalid date "tomorrow", expected YYYY-MM-DD`}, true},
+		{"UnknownAttribute", "api-linter: core::0140=disabled untill=2027-01-01", disableDirective{rule: "core::0140"}, true},
+		{
+			"LegacyTrailingText",
+			"(-- api-linter: core::0140::lower-snake=disabled see=b/123 my-reason=none (legacy) --)",
+			disableDirective{rule: "core::0140::lower-snake"},
+			true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := parseDisableDirective(test.line)
+			if ok != test.ok || !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Got %+v, %v, expected %+v, %v.", got, ok, test.want, test.ok)
+			}
+		})
+	}
+}
+
+func TestDisablePolicy(t *testing.T) {
+	now := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		name      string
+		directive disableDirective
+		policy    disablePolicy
+		want      bool
+	}{
+		{"Plain", disableDirective{rule: "core"}, disablePolicy{now: now}, true},
+		{"NotExpired", disableDirective{rule: "core", until: now.AddDate(0, 0, 1)}, disablePolicy{now: now}, true},
+		{"Expired", disableDirective{rule: "core", until: now}, disablePolicy{now: now}, false},
+		{"Invalid", disableDirective{rule: "core", err: "bad"}, disablePolicy{now: now}, false},
+		{"MissingReason", disableDirective{rule: "core"}, disablePolicy{now: now, requireReason: true}, false},
+		{"Reason", disableDirective{rule: "core", reason: "legacy"}, disablePolicy{now: now, requireReason: true}, true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.policy.applies(test.directive); got != test.want {
+				t.Errorf("Got %v, expected %v.", got, test.want)
+			}
+		})
+	}
+}
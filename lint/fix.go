@@ -0,0 +1,120 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Fix describes a change to the source files that resolves a Problem.
+//
+// Unlike a `Suggestion`, which replaces the text at the location of the
+// problem, a Fix can insert text and edit several locations at once (for
+// example, to add an annotation along with the import it requires).
+type Fix struct {
+	// Title provides a short description of the fix, such as
+	// "Add `option java_package`". This is shown in IDEs.
+	Title string
+
+	// Edits provides the changes that make up the fix. They must not
+	// overlap, and are applied together or not at all.
+	Edits []TextEdit
+
+	//nolint:unused // field is required to prevent positional parameters
+	noPositional struct{}
+}
+
+// TextEdit describes a replacement of a span of text in a file.
+type TextEdit struct {
+	// FilePath provides the path of the file to edit.
+	//
+	// If unset, the file of the problem's descriptor is edited.
+	FilePath string
+
+	// Location provides the span of text to replace. To insert text, use a
+	// span that starts and ends at the same position.
+	//
+	// The best way to set this is by using the helper methods in the
+	// `locations` package.
+	Location *dpb.SourceCodeInfo_Location
+
+	// NewText provides the text that replaces the span.
+	NewText string
+
+	//nolint:unused // field is required to prevent positional parameters
+	noPositional struct{}
+}
+
+// GetFixes returns the fixes of the problem.
+//
+// A `Suggestion` with a `Location` is returned as a fix that replaces the
+// location with the suggestion, after any explicit `Fixes`. The file path of
+// every edit is set.
+func (p Problem) GetFixes() []Fix {
+	var path string
+	if p.Descriptor != nil {
+		path = p.Descriptor.ParentFile().Path()
+	}
+
+	fixes := make([]Fix, 0, len(p.Fixes)+1)
+	for _, f := range p.Fixes {
+		edits := make([]TextEdit, 0, len(f.Edits))
+		for _, e := range f.Edits {
+			if e.FilePath == "" {
+				e.FilePath = path
+			}
+			edits = append(edits, e)
+		}
+		fixes = append(fixes, Fix{Title: f.Title, Edits: edits})
+	}
+	if p.Suggestion != "" && p.Location != nil {
+		fixes = append(fixes, Fix{
+			Title: fmt.Sprintf("Replace with `%s`", p.Suggestion),
+			Edits: []TextEdit{{FilePath: path, Location: p.Location, NewText: p.Suggestion}},
+		})
+	}
+	return fixes
+}
+
+// marshalFixes returns a serializable representation of the explicit fixes
+// of a problem.
+func (p Problem) marshalFixes() []marshaledFix {
+	if len(p.Fixes) == 0 {
+		return nil
+	}
+	fixes := []marshaledFix{}
+	for _, f := range p.GetFixes()[:len(p.Fixes)] {
+		mf := marshaledFix{Title: f.Title}
+		for _, e := range f.Edits {
+			fl := fileLocationFromPBLocation(e.Location, nil)
+			fl.Path = e.FilePath
+			mf.Edits = append(mf.Edits, marshaledTextEdit{Location: fl, NewText: e.NewText})
+		}
+		fixes = append(fixes, mf)
+	}
+	return fixes
+}
+
+type marshaledFix struct {
+	Title string              `json:"title" yaml:"title"`
+	Edits []marshaledTextEdit `json:"edits" yaml:"edits"`
+}
+
+type marshaledTextEdit struct {
+	Location fileLocation `json:"location" yaml:"location"`
+	NewText  string       `json:"new_text" yaml:"new_text"`
+}
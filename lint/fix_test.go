@@ -0,0 +1,100 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+	"gopkg.in/yaml.v3"
+)
+
+func TestProblemGetFixes(t *testing.T) {
+	fd, err := protodesc.NewFile(&dpb.FileDescriptorProto{Name: proto.String("foo.proto")}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	problem := Problem{
+		Message:    "foo bar",
+		Descriptor: fd,
+		Suggestion: "baz",
+		Location:   &dpb.SourceCodeInfo_Location{Span: []int32{2, 0, 3}},
+		Fixes: []Fix{{
+			Title: "Add an import",
+			Edits: []TextEdit{
+				{Location: &dpb.SourceCodeInfo_Location{Span: []int32{1, 0, 0}}, NewText: "import \"bar.proto\";\n"},
+				{FilePath: "bar.proto", Location: &dpb.SourceCodeInfo_Location{Span: []int32{0, 0, 0}}, NewText: "// Bar.\n"},
+			},
+		}},
+	}
+
+	fixes := problem.GetFixes()
+	if len(fixes) != 2 {
+		t.Fatalf("GetFixes() returned %d fixes, want 2", len(fixes))
+	}
+	if got, want := fixes[0].Edits[0].FilePath, "foo.proto"; got != want {
+		t.Errorf("GetFixes() edit path = %q, want %q", got, want)
+	}
+	if got, want := fixes[0].Edits[1].FilePath, "bar.proto"; got != want {
+		t.Errorf("GetFixes() edit path = %q, want %q", got, want)
+	}
+	if got := fixes[1].Edits; len(got) != 1 || got[0].NewText != "baz" || got[0].Location != problem.Location {
+		t.Errorf("GetFixes() suggestion edits = %v, want a replacement with %q", got, "baz")
+	}
+	if problem.Fixes[0].Edits[0].FilePath != "" {
+		t.Errorf("GetFixes() must not modify the problem")
+	}
+
+	jsonProblem, err := json.Marshal(problem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	yamlProblem, err := yaml.Marshal(problem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		name       string
+		serialized string
+		tokens     []string
+	}{
+		{"JSON", string(jsonProblem), []string{
+			`"fixes":[{"title":"Add an import","edits":[{`,
+			`"path":"foo.proto"},"new_text":"import \"bar.proto\";\n"}`,
+			`"path":"bar.proto"},"new_text":"// Bar.\n"}`,
+		}},
+		{"YAML", string(yamlProblem), []string{
+			"fixes:\n    - title: Add an import\n      edits:\n",
+			"path: bar.proto\n",
+			"new_text: |\n",
+		}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			for _, token := range test.tokens {
+				if !strings.Contains(test.serialized, token) {
+					t.Errorf("Got\n%v\nExpected `%s` to be present.", test.serialized, token)
+				}
+			}
+			// The suggestion is serialized on its own, not as a fix.
+			if strings.Count(test.serialized, "baz") != 1 {
+				t.Errorf("Got\n%v\nExpected the suggestion to be present once.", test.serialized)
+			}
+		})
+	}
+}
@@ -19,8 +19,11 @@ package lint
 import (
 	"errors"
 	"fmt"
+	"runtime"
 	"runtime/debug"
+	"sort"
 	"strings"
+	"sync"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
@@ -31,6 +34,13 @@ type Linter struct {
 	configs               Configs
 	debug                 bool
 	ignoreCommentDisables bool
+	concurrency           int
+	groupByDescriptor     bool
+	reportUnusedDisables  bool
+
+	// configuredRules caches the rules configured with options, keyed by
+	// rule name and options.
+	configuredRules sync.Map
 }
 
 // LinterOption prvoides the ability to configure the Linter.
@@ -50,11 +60,34 @@ func IgnoreCommentDisables(ignoreCommentDisables bool) LinterOption {
 	}
 }
 
+// Concurrency is a LinterOption for setting how many tasks may run at the
+// same time. Every rule runs against every file as a separate task.
+//
+// A value less than one uses one goroutine per available CPU. By default,
+// tasks run one at a time.
+func Concurrency(n int) LinterOption {
+	return func(l *Linter) {
+		l.concurrency = n
+	}
+}
+
+// GroupByDescriptor is a LinterOption for setting if the problems of a file
+// are grouped by descriptor.
+//
+// The groups are ordered by the position of their first problem, and the
+// problems in a group remain sorted by position, then by rule ID.
+func GroupByDescriptor(groupByDescriptor bool) LinterOption {
+	return func(l *Linter) {
+		l.groupByDescriptor = groupByDescriptor
+	}
+}
+
 // New creates and returns a linter with the given rules and configs.
 func New(rules RuleRegistry, configs Configs, opts ...LinterOption) *Linter {
 	l := &Linter{
-		rules:   rules,
-		configs: configs,
+		rules:       rules,
+		configs:     configs,
+		concurrency: 1,
 	}
 
 	for _, opt := range opts {
@@ -65,10 +98,18 @@ func New(rules RuleRegistry, configs Configs, opts ...LinterOption) *Linter {
 }
 
 // LintProtos checks protobuf files and returns a list of problems or an error.
+//
+// The responses are in the order of the files, and the problems of each
+// response are sorted by their position in the file, then by rule ID, so
+// that the output is the same from one run to the next.
+//
+// API rules (see ProtoAPIRule) run once for each proto package, against the
+// files of that package.
 func (l *Linter) LintProtos(files ...protoreflect.FileDescriptor) ([]Response, error) {
+	results := l.lintFiles(files)
 	var responses []Response
-	for _, proto := range files {
-		resp, err := l.lintFileDescriptor(proto)
+	for i, fd := range files {
+		resp, err := l.newResponse(fd, results[i])
 		if err != nil {
 			return nil, err
 		}
@@ -77,48 +118,268 @@ func (l *Linter) LintProtos(files ...protoreflect.FileDescriptor) ([]Response, e
 	return responses, nil
 }
 
-// run executes rules on the request.
-//
-// It uses the proto file path to determine which rules will
-// be applied to the request, according to the list of Linter
-// configs.
+// lintFileDescriptor runs every rule against a single file.
 func (l *Linter) lintFileDescriptor(fd protoreflect.FileDescriptor) (Response, error) {
+	return l.newResponse(fd, l.lintFiles([]protoreflect.FileDescriptor{fd})[0])
+}
+
+// lintFiles runs every rule against the files, and returns the results by
+// file and rule.
+func (l *Linter) lintFiles(files []protoreflect.FileDescriptor) [][]ruleResult {
+	// Every rule runs against every file (or every API, for API rules) as a
+	// separate task. The results are stored by file and rule, so that they
+	// can be collected in a stable order regardless of the order in which
+	// the tasks finish.
+	names := l.ruleNames()
+	results := make([][]ruleResult, len(files))
+	for i := range files {
+		results[i] = make([]ruleResult, len(names))
+	}
+	type task struct {
+		rule int
+		// The file to lint, or the files of the API to lint for API rules.
+		file int
+		api  []int
+	}
+	var tasks []task
+	apis := groupByPackage(files)
+	for j, name := range names {
+		if _, ok := l.rules[name].(ProtoAPIRule); ok {
+			for _, api := range apis {
+				tasks = append(tasks, task{rule: j, api: api})
+			}
+			continue
+		}
+		for i := range files {
+			tasks = append(tasks, task{rule: j, file: i})
+		}
+	}
+	l.runTasks(len(tasks), func(t int) {
+		task := tasks[t]
+		if task.api == nil {
+			results[task.file][task.rule] = l.lintFileWithRule(files[task.file], names[task.rule])
+			return
+		}
+		// The APIs do not share any file, so the tasks write to separate
+		// results.
+		for i, result := range l.lintAPIWithRule(files, task.api, names[task.rule]) {
+			results[i][task.rule] = result
+		}
+	})
+	return results
+}
+
+// groupByPackage returns the indexes of the files of each proto package, in
+// the order of the first file of each package.
+func groupByPackage(files []protoreflect.FileDescriptor) [][]int {
+	var apis [][]int
+	index := map[protoreflect.FullName]int{}
+	for i, fd := range files {
+		j, ok := index[fd.Package()]
+		if !ok {
+			j = len(apis)
+			index[fd.Package()] = j
+			apis = append(apis, nil)
+		}
+		apis[j] = append(apis[j], i)
+	}
+	return apis
+}
+
+// newResponse combines the results of the rules run against a file, in the
+// order of the results. The problems are sorted by their position.
+func (l *Linter) newResponse(fd protoreflect.FileDescriptor, results []ruleResult) (Response, error) {
 	resp := Response{
 		FilePath: fd.Path(),
 		Problems: []Problem{},
 	}
 	var errMessages []string
-
-	for name, rule := range l.rules {
-		// Run the linter rule against this file, and throw away any problems
-		// which should have been disabled.
-		if l.configs.IsRuleEnabled(string(name), fd.Path()) {
-			if problems, err := l.runAndRecoverFromPanics(rule, fd); err == nil {
-				for _, p := range problems {
-					if p.Descriptor == nil {
-						errMessages = append(errMessages, fmt.Sprintf("rule %q missing required Descriptor in returned Problem", rule.GetName()))
-						continue
-					}
-					if ruleIsEnabled(rule, p.Descriptor, p.Location, aliasMap, l.ignoreCommentDisables) {
-						p.RuleID = rule.GetName()
-						resp.Problems = append(resp.Problems, p)
-					}
-				}
-			} else {
-				errMessages = append(errMessages, err.Error())
-			}
-		}
+	for _, r := range results {
+		resp.Problems = append(resp.Problems, r.problems...)
+		errMessages = append(errMessages, r.errMessages...)
+	}
+	resp.Problems = append(resp.Problems, l.disableCommentProblems(fd, results)...)
+	sortProblems(resp.Problems)
+	if l.groupByDescriptor {
+		groupProblems(resp.Problems)
 	}
 
 	var err error
 	if len(errMessages) != 0 {
 		err = errors.New(strings.Join(errMessages, "; "))
 	}
-
 	return resp, err
 }
 
-func (l *Linter) runAndRecoverFromPanics(rule ProtoRule, fd protoreflect.FileDescriptor) (probs []Problem, err error) {
+// ruleNames returns the names of the registered rules, in a stable order.
+func (l *Linter) ruleNames() []RuleName {
+	names := make([]RuleName, 0, len(l.rules))
+	for name := range l.rules {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
+
+// runTasks calls run for every task in [0, n), using up to l.concurrency
+// goroutines.
+func (l *Linter) runTasks(n int, run func(task int)) {
+	workers := l.concurrency
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers == 1 || n < 2 {
+		for task := 0; task < n; task++ {
+			run(task)
+		}
+		return
+	}
+
+	tasks := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(workers, n); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for task := range tasks {
+				run(task)
+			}
+		}()
+	}
+	for task := 0; task < n; task++ {
+		tasks <- task
+	}
+	close(tasks)
+	wg.Wait()
+}
+
+// ruleResult holds the outcome of running a single rule against a file.
+type ruleResult struct {
+	problems    []Problem
+	errMessages []string
+
+	// ran is whether the rule ran against the file.
+	ran bool
+	// usedDisables are the disable comments that suppressed problems.
+	usedDisables []disableComment
+}
+
+// lintFileWithRule runs a rule against a file.
+//
+// It uses the proto file path to determine whether the rule will
+// be applied to the file, according to the list of Linter
+// configs.
+func (l *Linter) lintFileWithRule(fd protoreflect.FileDescriptor, name RuleName) ruleResult {
+	var result ruleResult
+
+	// Run the linter rule against this file, and throw away any problems
+	// which should have been disabled.
+	if !l.configs.IsRuleEnabled(string(name), fd.Path()) {
+		return result
+	}
+	rule, err := l.configuredRule(name, fd.Path())
+	if err != nil {
+		result.errMessages = append(result.errMessages, err.Error())
+		return result
+	}
+	problems, err := l.runAndRecoverFromPanics(func() ([]Problem, error) { return rule.Lint(fd), nil })
+	if err != nil {
+		result.errMessages = append(result.errMessages, err.Error())
+		return result
+	}
+	result.ran = true
+	policy := l.disablePolicy(fd.Path())
+	for _, p := range problems {
+		if p.Descriptor == nil {
+			result.errMessages = append(result.errMessages, fmt.Sprintf("rule %q missing required Descriptor in returned Problem", rule.GetName()))
+			continue
+		}
+		enabled, disabledBy := ruleIsEnabledBy(rule, p.Descriptor, p.Location, aliasMap, l.ignoreCommentDisables, policy)
+		if enabled {
+			p.RuleID = rule.GetName()
+			p.Severity = l.configs.RuleSeverity(string(name), fd.Path())
+			result.problems = append(result.problems, p)
+		} else if disabledBy != nil {
+			result.usedDisables = append(result.usedDisables, *disabledBy)
+		}
+	}
+	return result
+}
+
+// lintAPIWithRule runs an API rule against the files of an API, given by
+// their indexes, and returns the results by file index.
+//
+// The rule only sees the files for which it is enabled, according to the
+// list of Linter configs.
+func (l *Linter) lintAPIWithRule(files []protoreflect.FileDescriptor, api []int, name RuleName) map[int]ruleResult {
+	results := map[int]ruleResult{}
+
+	var enabled []protoreflect.FileDescriptor
+	indexes := map[string]int{}
+	for _, i := range api {
+		if l.configs.IsRuleEnabled(string(name), files[i].Path()) {
+			enabled = append(enabled, files[i])
+			indexes[files[i].Path()] = i
+		}
+	}
+	if len(enabled) == 0 {
+		return results
+	}
+	// The options of the rule are those of the first file of the API.
+	configured, err := l.configuredRule(name, enabled[0].Path())
+	if err != nil {
+		results[api[0]] = ruleResult{errMessages: []string{err.Error()}}
+		return results
+	}
+	rule, ok := configured.(ProtoAPIRule)
+	if !ok {
+		results[api[0]] = ruleResult{errMessages: []string{fmt.Sprintf("rule %q is not an API rule when configured with options", name)}}
+		return results
+	}
+
+	// Errors that are not about a specific file are reported on the first
+	// file of the API.
+	addError := func(i int, msg string) {
+		result := results[i]
+		result.errMessages = append(result.errMessages, msg)
+		results[i] = result
+	}
+	problems, err := l.runAndRecoverFromPanics(func() ([]Problem, error) { return rule.LintAPI(enabled) })
+	if err != nil {
+		addError(api[0], err.Error())
+		return results
+	}
+	for _, i := range indexes {
+		result := results[i]
+		result.ran = true
+		results[i] = result
+	}
+	for _, p := range problems {
+		if p.Descriptor == nil {
+			addError(api[0], fmt.Sprintf("rule %q missing required Descriptor in returned Problem", rule.GetName()))
+			continue
+		}
+		path := p.Descriptor.ParentFile().Path()
+		i, ok := indexes[path]
+		if !ok {
+			addError(api[0], fmt.Sprintf("rule %q returned a Problem in %q, which is not part of the API", rule.GetName(), path))
+			continue
+		}
+		result := results[i]
+		enabled, disabledBy := ruleIsEnabledBy(rule, p.Descriptor, p.Location, aliasMap, l.ignoreCommentDisables, l.disablePolicy(path))
+		if enabled {
+			p.RuleID = rule.GetName()
+			p.Severity = l.configs.RuleSeverity(string(name), path)
+			result.problems = append(result.problems, p)
+		} else if disabledBy != nil {
+			result.usedDisables = append(result.usedDisables, *disabledBy)
+		}
+		results[i] = result
+	}
+	return results
+}
+
+func (l *Linter) runAndRecoverFromPanics(run func() ([]Problem, error)) (probs []Problem, err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			if l.debug {
@@ -132,5 +393,5 @@ func (l *Linter) runAndRecoverFromPanics(rule ProtoRule, fd protoreflect.FileDes
 		}
 	}()
 
-	return rule.Lint(fd), nil
+	return run()
 }
@@ -15,9 +15,13 @@
 package lint
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 	"testing"
 
 	"google.golang.org/protobuf/proto"
@@ -39,7 +43,7 @@ func TestLinter_run(t *testing.T) {
 	ruleProblems := []Problem{{
 		Message:    "rule1_problem",
 		Descriptor: fd,
-		category:   "",
+		Severity:   SeverityError,
 		RuleID:     testRuleName,
 	}}
 
@@ -106,6 +110,62 @@ func TestLinter_run(t *testing.T) {
 	}
 }
 
+func TestLinter_severity(t *testing.T) {
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name: proto.String("protofile.proto"),
+	}, nil)
+	if err != nil {
+		t.Fatalf("Failed to build a file descriptor.")
+	}
+	testRuleName := NewRuleName(111, "test-rule")
+
+	tests := []struct {
+		testName string
+		configs  Configs
+		want     Severity
+	}{
+		{"Default", Configs{}, SeverityError},
+		{
+			"Warning",
+			Configs{{RuleSeverities: map[string]Severity{"core::0111": SeverityWarning}}},
+			SeverityWarning,
+		},
+		{
+			"NonMatchingFile",
+			Configs{{
+				IncludedPaths:  []string{"nofile"},
+				RuleSeverities: map[string]Severity{"core::0111": SeverityWarning},
+			}},
+			SeverityError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			rules := NewRuleRegistry()
+			err := rules.Register(111, &FileRule{
+				Name: testRuleName,
+				LintFile: func(f protoreflect.FileDescriptor) []Problem {
+					return []Problem{{Message: "problem", Descriptor: f}}
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := New(rules, test.configs).lintFileDescriptor(fd)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(resp.Problems) != 1 {
+				t.Fatalf("Got %d problems, expected 1.", len(resp.Problems))
+			}
+			if got := resp.Problems[0].Severity; got != test.want {
+				t.Errorf("Got severity %q, expected %q.", got, test.want)
+			}
+		})
+	}
+}
+
 func TestLinter_LintProtos_RulePanics(t *testing.T) {
 	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
 		Name: proto.String("test.proto"),
@@ -158,6 +218,303 @@ func TestLinter_LintProtos_RulePanics(t *testing.T) {
 	}
 }
 
+func TestLinter_Concurrency(t *testing.T) {
+	var files []protoreflect.FileDescriptor
+	for i := 0; i < 20; i++ {
+		fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+			Name: proto.String(fmt.Sprintf("test%d.proto", i)),
+			MessageType: []*descriptorpb.DescriptorProto{
+				{Name: proto.String("Foo")},
+				{Name: proto.String("Bar")},
+			},
+		}, nil)
+		if err != nil {
+			t.Fatalf("Failed to build the file descriptor: %v", err)
+		}
+		files = append(files, fd)
+	}
+
+	rules := NewRuleRegistry()
+	for _, name := range []string{"first", "second", "third", "fourth"} {
+		err := rules.Register(111, &MessageRule{
+			Name: NewRuleName(111, name),
+			LintMessage: func(m protoreflect.MessageDescriptor) []Problem {
+				return []Problem{{Message: string(m.Name()), Descriptor: m}}
+			},
+		})
+		if err != nil {
+			t.Fatalf("Failed to create Rules: %q", err)
+		}
+	}
+
+	want, err := New(rules, nil).LintProtos(files...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range []int{0, 2, 8, 100} {
+		t.Run(fmt.Sprintf("Concurrency%d", n), func(t *testing.T) {
+			got, err := New(rules, nil, Concurrency(n)).LintProtos(files...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Got %v, expected %v.", got, want)
+			}
+		})
+	}
+
+	// A panic in one rule is reported without affecting the other workers.
+	err = rules.Register(111, &FileRule{
+		Name: NewRuleName(111, "panic"),
+		LintFile: func(_ protoreflect.FileDescriptor) []Problem {
+			panic("panic")
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create Rules: %q", err)
+	}
+	if _, err := New(rules, nil, Concurrency(8)).LintProtos(files...); err == nil || !strings.Contains(err.Error(), "panic") {
+		t.Errorf("Expected error with panic, got %q", err)
+	}
+}
+
+func TestLinter_APIRule(t *testing.T) {
+	newFile := func(name, pkg string, messages ...string) protoreflect.FileDescriptor {
+		fdp := &descriptorpb.FileDescriptorProto{
+			Name:    proto.String(name),
+			Package: proto.String(pkg),
+		}
+		for _, m := range messages {
+			fdp.MessageType = append(fdp.MessageType, &descriptorpb.DescriptorProto{Name: proto.String(m)})
+		}
+		fd, err := protodesc.NewFile(fdp, nil)
+		if err != nil {
+			t.Fatalf("Failed to build the file descriptor: %v", err)
+		}
+		return fd
+	}
+	files := []protoreflect.FileDescriptor{
+		newFile("a.proto", "test.v1", "Foo"),
+		newFile("b.proto", "test.v2", "Foo"),
+		newFile("c.proto", "test.v1", "Bar"),
+	}
+
+	// The rule reports the last message of each API on the first message.
+	var apis [][]string
+	var mu sync.Mutex
+	rules := NewRuleRegistry()
+	err := rules.Register(111, &APIRule{
+		Name: NewRuleName(111, "test-rule"),
+		LintFiles: func(files []protoreflect.FileDescriptor) []Problem {
+			var paths []string
+			for _, fd := range files {
+				paths = append(paths, fd.Path())
+			}
+			mu.Lock()
+			apis = append(apis, paths)
+			mu.Unlock()
+			first := files[0].Messages().Get(0)
+			last := files[len(files)-1].Messages().Get(0)
+			return []Problem{{Message: string(last.FullName()), Descriptor: first}}
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		testName string
+		configs  Configs
+		wantAPIs [][]string
+		want     map[string][]string
+	}{
+		{
+			testName: "ByPackage",
+			wantAPIs: [][]string{{"a.proto", "c.proto"}, {"b.proto"}},
+			want: map[string][]string{
+				"a.proto": {"test.v1.Bar"},
+				"b.proto": {"test.v2.Foo"},
+			},
+		},
+		{
+			testName: "DisabledForFile",
+			configs:  Configs{{IncludedPaths: []string{"a.proto"}, DisabledRules: []string{"all"}}},
+			wantAPIs: [][]string{{"c.proto"}, {"b.proto"}},
+			want: map[string][]string{
+				"b.proto": {"test.v2.Foo"},
+				"c.proto": {"test.v1.Bar"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			apis = nil
+			resps, err := New(rules, test.configs).LintProtos(files...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(apis, test.wantAPIs) {
+				t.Errorf("Got APIs %v, expected %v.", apis, test.wantAPIs)
+			}
+			got := map[string][]string{}
+			for _, resp := range resps {
+				for _, p := range resp.Problems {
+					if p.RuleID != NewRuleName(111, "test-rule") {
+						t.Errorf("Got rule ID %q, expected the API rule.", p.RuleID)
+					}
+					got[resp.FilePath] = append(got[resp.FilePath], p.Message)
+				}
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Got %v, expected %v.", got, test.want)
+			}
+		})
+	}
+
+	// Problems in files outside of the API are an error.
+	rules = NewRuleRegistry()
+	err = rules.Register(111, &APIRule{
+		Name: NewRuleName(111, "test-rule"),
+		LintFiles: func(_ []protoreflect.FileDescriptor) []Problem {
+			return []Problem{{Message: "other", Descriptor: files[1]}}
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := New(rules, nil).LintProtos(files[0]); err == nil || !strings.Contains(err.Error(), "not part of the API") {
+		t.Errorf("Expected an error for a problem outside of the API, got %v", err)
+	}
+
+	// The error of a rule that fails to lint is reported without a panic.
+	rules = NewRuleRegistry()
+	err = rules.Register(111, &APIRule{
+		Name: NewRuleName(111, "test-rule"),
+		TryLintFiles: func(_ []protoreflect.FileDescriptor) ([]Problem, error) {
+			return nil, errors.New("failed to lint")
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := New(rules, nil).LintProtos(files[0]); err == nil || !strings.Contains(err.Error(), "failed to lint") {
+		t.Errorf("Expected the error of the rule, got %v", err)
+	}
+}
+
+func TestLinter_ProblemOrder(t *testing.T) {
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name: proto.String("test.proto"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{Name: proto.String("Foo")},
+			{Name: proto.String("Bar")},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("Failed to build the file descriptor: %v", err)
+	}
+	foo, bar := fd.Messages().Get(0), fd.Messages().Get(1)
+	problem := func(d protoreflect.Descriptor, line int32) Problem {
+		return Problem{
+			Message:    fmt.Sprintf("%s:%d", d.Name(), line),
+			Descriptor: d,
+			Location:   &descriptorpb.SourceCodeInfo_Location{Span: []int32{line, 0, 1}},
+		}
+	}
+
+	rules := NewRuleRegistry()
+	err = rules.Register(111,
+		&MessageRule{
+			Name: NewRuleName(111, "zeta"),
+			LintMessage: func(m protoreflect.MessageDescriptor) []Problem {
+				return []Problem{problem(m, int32(m.Index()*10+5))}
+			},
+		},
+		&MessageRule{
+			Name: NewRuleName(111, "alpha"),
+			LintMessage: func(m protoreflect.MessageDescriptor) []Problem {
+				line := int32(m.Index() * 10)
+				return []Problem{problem(m, line+5), problem(m, line+1)}
+			},
+		},
+		&FileRule{
+			Name: NewRuleName(111, "file"),
+			LintFile: func(f protoreflect.FileDescriptor) []Problem {
+				return []Problem{problem(f.Messages().Get(0), 20)}
+			},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Failed to create Rules: %q", err)
+	}
+
+	type key struct {
+		desc protoreflect.Descriptor
+		line int32
+		rule string
+	}
+	tests := []struct {
+		name string
+		opts []LinterOption
+		want []key
+	}{
+		{
+			name: "Sorted",
+			want: []key{
+				{foo, 1, "alpha"},
+				{foo, 5, "alpha"},
+				{foo, 5, "zeta"},
+				{bar, 11, "alpha"},
+				{bar, 15, "alpha"},
+				{bar, 15, "zeta"},
+				{foo, 20, "file"},
+			},
+		},
+		{
+			name: "GroupByDescriptor",
+			opts: []LinterOption{GroupByDescriptor(true)},
+			want: []key{
+				{foo, 1, "alpha"},
+				{foo, 5, "alpha"},
+				{foo, 5, "zeta"},
+				{foo, 20, "file"},
+				{bar, 11, "alpha"},
+				{bar, 15, "alpha"},
+				{bar, 15, "zeta"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var first []byte
+			for i := 0; i < 20; i++ {
+				resp, err := New(rules, nil, append(test.opts, Concurrency(0))...).LintProtos(fd)
+				if err != nil {
+					t.Fatal(err)
+				}
+				var got []key
+				for _, p := range resp[0].Problems {
+					got = append(got, key{p.Descriptor, p.Location.GetSpan()[0], strings.TrimPrefix(string(p.RuleID), "core::0111::")})
+				}
+				if !reflect.DeepEqual(got, test.want) {
+					t.Fatalf("Got %v, expected %v.", got, test.want)
+				}
+
+				// The serialized output must not change between runs.
+				b, err := json.Marshal(resp)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if first == nil {
+					first = b
+				} else if !bytes.Equal(b, first) {
+					t.Fatalf("Run %d produced different output:\n%s\nexpected:\n%s", i, b, first)
+				}
+			}
+		})
+	}
+}
+
 func TestLinter_debug(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -16,6 +16,7 @@ package lint
 
 import (
 	"encoding/json"
+	"sort"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
 	dpb "google.golang.org/protobuf/types/descriptorpb"
@@ -39,6 +40,12 @@ type Problem struct {
 	// precise.
 	Suggestion string
 
+	// Fixes provides structured fixes, if applicable.
+	//
+	// Unlike `Suggestion`, a fix can insert text or edit several locations,
+	// possibly in several files. See `Fix` for details.
+	Fixes []Fix
+
 	// Descriptor provides the descriptor related to the problem. This must be
 	// set on every Problem.
 	//
@@ -57,8 +64,10 @@ type Problem struct {
 	// DO NOT SET: The linter sets this automatically.
 	RuleID RuleName // FIXME: Make this private (cmd/summary_cli.go is the challenge).
 
-	// The category for this problem, based on user configuration.
-	category string
+	// Severity provides the severity of this problem, based on user
+	// configuration.
+	// DO NOT SET: The linter sets this automatically.
+	Severity Severity
 
 	//nolint:unused // field is required to prevent positional parameters
 	noPositional struct{}
@@ -103,20 +112,92 @@ func (p Problem) marshal() interface{} {
 
 	// Return a marshal-able structure.
 	return struct {
-		Message    string       `json:"message" yaml:"message"`
-		Suggestion string       `json:"suggestion,omitempty" yaml:"suggestion,omitempty"`
-		Location   fileLocation `json:"location" yaml:"location"`
-		RuleID     RuleName     `json:"rule_id" yaml:"rule_id"`
-		RuleDocURI string       `json:"rule_doc_uri" yaml:"rule_doc_uri"`
-		Category   string       `json:"category,omitempty" yaml:"category,omitempty"`
+		Message    string         `json:"message" yaml:"message"`
+		Suggestion string         `json:"suggestion,omitempty" yaml:"suggestion,omitempty"`
+		Location   fileLocation   `json:"location" yaml:"location"`
+		RuleID     RuleName       `json:"rule_id" yaml:"rule_id"`
+		RuleDocURI string         `json:"rule_doc_uri" yaml:"rule_doc_uri"`
+		Severity   Severity       `json:"severity,omitempty" yaml:"severity,omitempty"`
+		Fixes      []marshaledFix `json:"fixes,omitempty" yaml:"fixes,omitempty"`
 	}{
 		p.Message,
 		p.Suggestion,
 		fl,
 		p.RuleID,
 		p.GetRuleURI(),
-		p.category,
+		p.Severity,
+		p.marshalFixes(),
+	}
+}
+
+// start returns the zero-based line and column where the problem starts.
+func (p Problem) start() (line, column int) {
+	if span := p.Location.GetSpan(); len(span) >= 2 {
+		return int(span[0]), int(span[1])
+	}
+	if p.Descriptor != nil {
+		loc := p.Descriptor.ParentFile().SourceLocations().ByDescriptor(p.Descriptor)
+		return loc.StartLine, loc.StartColumn
+	}
+	return 0, 0
+}
+
+// end returns the zero-based line and column where the problem ends.
+func (p Problem) end() (line, column int) {
+	switch span := p.Location.GetSpan(); len(span) {
+	case 3:
+		return int(span[0]), int(span[2])
+	case 4:
+		return int(span[2]), int(span[3])
+	}
+	if p.Descriptor != nil {
+		loc := p.Descriptor.ParentFile().SourceLocations().ByDescriptor(p.Descriptor)
+		return loc.EndLine, loc.EndColumn
+	}
+	return 0, 0
+}
+
+// sortProblems sorts problems by their starting position, then by rule ID,
+// ending position and message, so that the order does not depend on the
+// order in which the rules reported them.
+func sortProblems(problems []Problem) {
+	sort.SliceStable(problems, func(i, j int) bool {
+		iLine, iCol := problems[i].start()
+		jLine, jCol := problems[j].start()
+		if iLine != jLine {
+			return iLine < jLine
+		}
+		if iCol != jCol {
+			return iCol < jCol
+		}
+		if problems[i].RuleID != problems[j].RuleID {
+			return problems[i].RuleID < problems[j].RuleID
+		}
+		iLine, iCol = problems[i].end()
+		jLine, jCol = problems[j].end()
+		if iLine != jLine {
+			return iLine < jLine
+		}
+		if iCol != jCol {
+			return iCol < jCol
+		}
+		return problems[i].Message < problems[j].Message
+	})
+}
+
+// groupProblems reorders sorted problems so that the problems of each
+// descriptor are next to each other, keeping their relative order. The
+// descriptors are ordered by their first problem.
+func groupProblems(problems []Problem) {
+	groups := map[protoreflect.Descriptor]int{}
+	for _, p := range problems {
+		if _, ok := groups[p.Descriptor]; !ok {
+			groups[p.Descriptor] = len(groups)
+		}
 	}
+	sort.SliceStable(problems, func(i, j int) bool {
+		return groups[problems[i].Descriptor] < groups[problems[j].Descriptor]
+	})
 }
 
 // GetRuleURI returns a URI to learn more about the problem.
@@ -133,3 +133,25 @@ func TestProblemDescriptor(t *testing.T) {
 		})
 	}
 }
+
+func TestSortProblems(t *testing.T) {
+	span := func(s ...int32) *dpb.SourceCodeInfo_Location {
+		return &dpb.SourceCodeInfo_Location{Span: s}
+	}
+	problems := []Problem{
+		{Message: "b", RuleID: "core::0001::a", Location: span(1, 0, 5)},
+		{Message: "a", RuleID: "core::0001::a", Location: span(1, 0, 5)},
+		{Message: "a", RuleID: "core::0001::a", Location: span(1, 0, 2, 0)},
+		{Message: "a", RuleID: "core::0001::a", Location: span(1, 0, 3)},
+		{Message: "a", RuleID: "core::0000::b", Location: span(1, 0, 9)},
+		{Message: "a", RuleID: "core::0000::a", Location: span(1, 2, 3)},
+		{Message: "a", RuleID: "core::0000::a", Location: span(0, 4, 5)},
+	}
+	want := []Problem{problems[6], problems[4], problems[3], problems[1], problems[0], problems[2], problems[5]}
+	sortProblems(problems)
+	for i := range want {
+		if problems[i].Message != want[i].Message || problems[i].RuleID != want[i].RuleID || !proto.Equal(problems[i].Location, want[i].Location) {
+			t.Errorf("Got problem %d %v, expected %v.", i, problems[i], want[i])
+		}
+	}
+}
@@ -15,7 +15,6 @@
 package lint
 
 import (
-	"regexp"
 	"strings"
 
 	"google.golang.org/protobuf/reflect/protodesc"
@@ -29,7 +28,8 @@ import (
 // but most rule authors will want to use the implementations provided.
 //
 // Rules must only report errors in the file under which they are being run
-// (not imported files).
+// (not imported files). Rules that check several files together implement
+// ProtoAPIRule instead.
 type ProtoRule interface {
 	// GetName returns the name of the rule.
 	GetName() RuleName
@@ -43,6 +43,9 @@ type ProtoRule interface {
 type FileRule struct {
 	Name RuleName
 
+	// RuleInfo describes the rule. Optional.
+	RuleInfo
+
 	// LintFile accepts a FileDescriptor and lints it, returning a slice of
 	// Problems it finds.
 	LintFile func(protoreflect.FileDescriptor) []Problem
@@ -75,6 +78,9 @@ func (r *FileRule) Lint(fd protoreflect.FileDescriptor) []Problem {
 type MessageRule struct {
 	Name RuleName
 
+	// RuleInfo describes the rule. Optional.
+	RuleInfo
+
 	// LintMessage accepts a MessageDescriptor and lints it, returning a slice
 	// of Problems it finds.
 	LintMessage func(protoreflect.MessageDescriptor) []Problem
@@ -112,6 +118,9 @@ func (r *MessageRule) Lint(fd protoreflect.FileDescriptor) []Problem {
 type FieldRule struct {
 	Name RuleName
 
+	// RuleInfo describes the rule. Optional.
+	RuleInfo
+
 	// LintField accepts a FieldDescriptor and lints it, returning a slice of
 	// Problems it finds.
 	LintField func(protoreflect.FieldDescriptor) []Problem
@@ -153,6 +162,9 @@ func (r *FieldRule) Lint(fd protoreflect.FileDescriptor) []Problem {
 type ServiceRule struct {
 	Name RuleName
 
+	// RuleInfo describes the rule. Optional.
+	RuleInfo
+
 	// LintService accepts a ServiceDescriptor and lints it.
 	LintService func(protoreflect.ServiceDescriptor) []Problem
 
@@ -188,6 +200,9 @@ func (r *ServiceRule) Lint(fd protoreflect.FileDescriptor) []Problem {
 type MethodRule struct {
 	Name RuleName
 
+	// RuleInfo describes the rule. Optional.
+	RuleInfo
+
 	// LintMethod accepts a MethodDescriptor and lints it.
 	LintMethod func(protoreflect.MethodDescriptor) []Problem
 
@@ -226,6 +241,9 @@ func (r *MethodRule) Lint(fd protoreflect.FileDescriptor) []Problem {
 type EnumRule struct {
 	Name RuleName
 
+	// RuleInfo describes the rule. Optional.
+	RuleInfo
+
 	// LintEnum accepts a EnumDescriptor and lints it.
 	LintEnum func(protoreflect.EnumDescriptor) []Problem
 
@@ -262,6 +280,9 @@ func (r *EnumRule) Lint(fd protoreflect.FileDescriptor) []Problem {
 type EnumValueRule struct {
 	Name RuleName
 
+	// RuleInfo describes the rule. Optional.
+	RuleInfo
+
 	// LintEnumValue accepts a EnumValueDescriptor and lints it.
 	LintEnumValue func(protoreflect.EnumValueDescriptor) []Problem
 
@@ -302,6 +323,9 @@ func (r *EnumValueRule) Lint(fd protoreflect.FileDescriptor) []Problem {
 type DescriptorRule struct {
 	Name RuleName
 
+	// RuleInfo describes the rule. Optional.
+	RuleInfo
+
 	// LintDescriptor accepts a generic descriptor and lints it.
 	//
 	// Note: Unless the descriptor is typecast to a more specific type,
@@ -372,14 +396,90 @@ func (r *DescriptorRule) Lint(fd protoreflect.FileDescriptor) []Problem {
 	return problems
 }
 
-var disableRuleNameRegex = regexp.MustCompile(`api-linter:\s*(.+)\s*=\s*disabled`)
+// ProtoAPIRule defines a lint rule that checks the files of an API
+// together, rather than one file at a time.
+//
+// The linter groups the files being linted by proto package, so that an API
+// is the set of files of one package (and therefore, of one API version)
+// that are linted at the same time. Rules may report problems in any of
+// these files, but not in the files they import.
+type ProtoAPIRule interface {
+	ProtoRule
+
+	// LintAPI accepts the FileDescriptors of an API and lints them,
+	// returning a slice of Problem objects it finds, or an error if it
+	// could not lint them, such as a plugin that failed to run. The linter
+	// reports the error instead of the problems.
+	LintAPI([]protoreflect.FileDescriptor) ([]Problem, error)
+}
+
+// APIRule defines a lint rule that checks every file of an API at once.
+//
+// It is useful for checks that span files, such as requiring that a
+// resource defined in one file has a standard method somewhere in the API.
+type APIRule struct {
+	Name RuleName
+
+	// RuleInfo describes the rule. Optional.
+	RuleInfo
+
+	// LintFiles accepts the FileDescriptors of an API, and lints them,
+	// returning a slice of Problems it finds in any of them.
+	LintFiles func([]protoreflect.FileDescriptor) []Problem
+
+	// TryLintFiles is like LintFiles, for the rules that may fail to lint
+	// the files, such as the rules provided by plugins. It is used instead
+	// of LintFiles if set.
+	TryLintFiles func([]protoreflect.FileDescriptor) ([]Problem, error)
+
+	// OnlyIf accepts a FileDescriptor and determines whether the file is
+	// part of the API checked by this rule.
+	OnlyIf func(protoreflect.FileDescriptor) bool
+
+	//nolint:unused // field is required to prevent positional parameters
+	noPositional struct{}
+}
+
+// GetName returns the name of the rule.
+func (r *APIRule) GetName() RuleName {
+	return r.Name
+}
 
-func extractDisabledRuleName(commentLine string) string {
-	match := disableRuleNameRegex.FindStringSubmatch(commentLine)
-	if len(match) > 0 {
-		return match[1]
+// Lint lints a single file as an API of its own, and returns the problems
+// found in that file.
+//
+// Lint can not return an error of TryLintFiles, so the error is reported as a
+// problem on the file instead. The linter calls LintAPI, which returns it.
+func (r *APIRule) Lint(fd protoreflect.FileDescriptor) []Problem {
+	problems, err := r.LintAPI([]protoreflect.FileDescriptor{fd})
+	if err != nil {
+		return []Problem{{Message: err.Error(), Descriptor: fd}}
+	}
+	return problems
+}
+
+// LintAPI forwards the FileDescriptors to the LintFiles (or TryLintFiles)
+// method defined on the APIRule.
+//
+// If an `OnlyIf` function is provided on the rule, it is run against each
+// file, and the files for which it returns false are left out.
+func (r *APIRule) LintAPI(files []protoreflect.FileDescriptor) ([]Problem, error) {
+	if r.OnlyIf != nil {
+		var applicable []protoreflect.FileDescriptor
+		for _, fd := range files {
+			if r.OnlyIf(fd) {
+				applicable = append(applicable, fd)
+			}
+		}
+		files = applicable
+	}
+	if len(files) == 0 {
+		return nil, nil
+	}
+	if r.TryLintFiles != nil {
+		return r.TryLintFiles(files)
 	}
-	return ""
+	return r.LintFiles(files), nil
 }
 
 func getLeadingComments(d protoreflect.Descriptor) string {
@@ -16,6 +16,7 @@ package lint
 
 import (
 	"strings"
+	"time"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
 	dpb "google.golang.org/protobuf/types/descriptorpb"
@@ -77,19 +78,28 @@ var descriptorDisableChecks = []func(d protoreflect.Descriptor, rule string) boo
 // augment the set of commentLines.
 func ruleIsEnabled(rule ProtoRule, d protoreflect.Descriptor, l *dpb.SourceCodeInfo_Location,
 	aliasMap map[string]string, ignoreCommentDisables bool) bool {
+	enabled, _ := ruleIsEnabledBy(rule, d, l, aliasMap, ignoreCommentDisables, disablePolicy{now: time.Now()})
+	return enabled
+}
+
+// ruleIsEnabledBy is like ruleIsEnabled, and also returns the comment that
+// disables the rule, if the rule is disabled by a comment. Only the comments
+// that apply under the policy disable rules.
+func ruleIsEnabledBy(rule ProtoRule, d protoreflect.Descriptor, l *dpb.SourceCodeInfo_Location,
+	aliasMap map[string]string, ignoreCommentDisables bool, policy disablePolicy) (bool, *disableComment) {
 	// If the rule is disabled because of something on the descriptor itself
 	// (e.g. a deprecated annotation), address that.
 	for _, mustDisable := range descriptorDisableChecks {
 		// The only thing the disable functions can do is force a rule to
 		// be disabled. (They can not force a rule to be enabled.)
 		if mustDisable(d, string(rule.GetName())) {
-			return false
+			return false, nil
 		}
 	}
 
 	if !ignoreCommentDisables {
-		if ruleIsDisabledByComments(rule, d, l, aliasMap) {
-			return false
+		if directive, ok := disablingDirective(rule, d, l, aliasMap, policy); ok {
+			return false, &disableComment{descriptor: d, disableDirective: directive}
 		}
 	}
 
@@ -99,15 +109,16 @@ func ruleIsEnabled(rule ProtoRule, d protoreflect.Descriptor, l *dpb.SourceCodeI
 	// Do not pass the source code location here, the source location in relation
 	// to the parent is not helpful.
 	if parent := d.Parent(); parent != nil {
-		return ruleIsEnabled(rule, parent, nil, aliasMap, ignoreCommentDisables)
+		return ruleIsEnabledBy(rule, parent, nil, aliasMap, ignoreCommentDisables, policy)
 	}
 
-	return true
+	return true, nil
 }
 
-// ruleIsDisabledByComments returns true if the rule has been disabled
-// by comments in the file or leading the element.
-func ruleIsDisabledByComments(rule ProtoRule, d protoreflect.Descriptor, l *dpb.SourceCodeInfo_Location, aliasMap map[string]string) bool {
+// disablingDirective returns the directive, in the comments in the file or
+// leading the element, that disables the given rule, if any.
+func disablingDirective(rule ProtoRule, d protoreflect.Descriptor, l *dpb.SourceCodeInfo_Location,
+	aliasMap map[string]string, policy disablePolicy) (disableDirective, bool) {
 	// Some rules have a legacy name. We add it to the check list.
 	ruleName := string(rule.GetName())
 	names := []string{ruleName, aliasMap[ruleName]}
@@ -116,24 +127,29 @@ func ruleIsDisabledByComments(rule ProtoRule, d protoreflect.Descriptor, l *dpb.
 	if l != nil {
 		commentLines = append(commentLines, strings.Split(l.GetLeadingComments(), "\n")...)
 	}
-	if f, ok := d.(protoreflect.FileDescriptor); ok {
-		commentLines = append(commentLines, strings.Split(fileHeader(f), "\n")...)
-	} else {
-		commentLines = append(commentLines, strings.Split(getLeadingComments(d), "\n")...)
-	}
-	disabledRules := []string{}
+	commentLines = append(commentLines, descriptorCommentLines(d)...)
+
 	for _, commentLine := range commentLines {
-		r := extractDisabledRuleName(commentLine)
-		if r != "" {
-			disabledRules = append(disabledRules, r)
+		directive, ok := parseDisableDirective(commentLine)
+		if !ok || !policy.applies(directive) {
+			continue
 		}
-	}
-
-	for _, name := range names {
-		if matchRule(name, disabledRules...) {
-			return true
+		for _, name := range names {
+			if matchRule(name, directive.rule) {
+				return directive, true
+			}
 		}
 	}
 
-	return false
+	return disableDirective{}, false
+}
+
+// descriptorCommentLines returns the lines of the comments that may disable
+// rules on a descriptor: the file header for a file, or the leading
+// comments of other descriptors.
+func descriptorCommentLines(d protoreflect.Descriptor) []string {
+	if f, ok := d.(protoreflect.FileDescriptor); ok {
+		return strings.Split(fileHeader(f), "\n")
+	}
+	return strings.Split(getLeadingComments(d), "\n")
 }
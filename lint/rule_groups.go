@@ -2,6 +2,15 @@ package lint
 
 import "fmt"
 
+// The groups of the rules that are not defined by an AIP.
+const (
+	// CustomRuleGroup is the group of the rules defined in configs.
+	CustomRuleGroup = "custom"
+
+	// PluginRuleGroup is the group of the rules provided by plugins.
+	PluginRuleGroup = "plugin"
+)
+
 // A list of functions, each of which returns the group name for the given AIP
 // number and if no group is found, returns an empty string.
 // NOTE: the list will be evaluated in the FILO order.
@@ -0,0 +1,137 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"strconv"
+	"strings"
+)
+
+// RuleMetadata describes a rule, so that tools can list the rules without
+// reading their documentation.
+type RuleMetadata struct {
+	// Name is the name of the rule.
+	Name RuleName
+
+	// Description is a short description of the rule, if the rule has one.
+	Description string
+
+	// AIP is the number of the AIP that the rule enforces, or zero if the
+	// name of the rule does not contain one.
+	AIP int
+
+	// Fixable is whether the problems of the rule may suggest a fix.
+	Fixable bool
+
+	// EnabledByDefault is whether the rule runs without being enabled in a
+	// config.
+	EnabledByDefault bool
+
+	// URL is the address of the documentation of the rule, if known.
+	URL string
+
+	// Examples are examples of code that the rule complains about.
+	Examples []RuleExample
+}
+
+// RuleExample is an example of code that a rule complains about, along
+// with the corrected code.
+type RuleExample struct {
+	// Title is a short title for the example. Optional.
+	Title string
+
+	// Incorrect is proto source code that the rule complains about.
+	Incorrect string
+
+	// Correct is the same source code, corrected.
+	Correct string
+}
+
+// RuleInfo is what a rule tells about itself. It is embedded in the rule
+// types of this package, which implement DescribedRule through it.
+type RuleInfo struct {
+	// Description is a short description of the rule. Optional.
+	Description string
+
+	// Fixable is whether the problems of the rule may suggest a fix.
+	Fixable bool
+
+	// Examples are examples of code that the rule complains about, for its
+	// documentation. Optional.
+	Examples []RuleExample
+}
+
+// GetDescription returns the description of the rule.
+func (i RuleInfo) GetDescription() string {
+	return i.Description
+}
+
+// IsFixable returns whether the problems of the rule may suggest a fix.
+func (i RuleInfo) IsFixable() bool {
+	return i.Fixable
+}
+
+// GetExamples returns the examples of the rule.
+func (i RuleInfo) GetExamples() []RuleExample {
+	return i.Examples
+}
+
+// DescribedRule is implemented by the rules that describe themselves, such
+// as the rules provided by this package.
+type DescribedRule interface {
+	ProtoRule
+
+	// GetDescription returns a short description of the rule.
+	GetDescription() string
+
+	// IsFixable returns whether the problems of the rule may suggest a fix.
+	IsFixable() bool
+
+	// GetExamples returns examples of code that the rule complains about.
+	GetExamples() []RuleExample
+}
+
+// GetRuleMetadata returns the metadata of a rule. The description, the
+// fixability and the examples are only known for the rules that implement
+// DescribedRule.
+func GetRuleMetadata(rule ProtoRule) RuleMetadata {
+	name := rule.GetName()
+	m := RuleMetadata{
+		Name:             name,
+		AIP:              ruleAIP(name),
+		EnabledByDefault: !matchRule(string(name), defaultDisabledRules...),
+		URL:              getRuleURL(string(name), ruleURLMappings),
+	}
+	if d, ok := rule.(DescribedRule); ok {
+		m.Description = d.GetDescription()
+		m.Fixable = d.IsFixable()
+		m.Examples = d.GetExamples()
+	}
+	return m
+}
+
+// ruleAIP returns the AIP number in a rule name of the form
+// "group::0000::name", or zero.
+func ruleAIP(name RuleName) int {
+	parts := strings.Split(string(name), nameSeparator)
+	if len(parts) != 3 {
+		return 0
+	}
+	aip, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0
+	}
+	return aip
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// undescribedRule is a rule that does not implement DescribedRule.
+type undescribedRule struct {
+	name RuleName
+}
+
+func (r undescribedRule) GetName() RuleName                          { return r.name }
+func (r undescribedRule) Lint(protoreflect.FileDescriptor) []Problem { return nil }
+
+func TestGetRuleMetadata(t *testing.T) {
+	tests := []struct {
+		name string
+		rule ProtoRule
+		want RuleMetadata
+	}{
+		{
+			name: "Described",
+			rule: &FieldRule{
+				Name: NewRuleName(140, "lower-snake"),
+				RuleInfo: RuleInfo{
+					Description: "Field names should use `snake_case`.",
+					Fixable:     true,
+				},
+			},
+			want: RuleMetadata{
+				Name:             "core::0140::lower-snake",
+				Description:      "Field names should use `snake_case`.",
+				AIP:              140,
+				Fixable:          true,
+				EnabledByDefault: true,
+				URL:              "https://linter.aip.dev/140/lower-snake",
+			},
+		},
+		{
+			name: "DisabledByDefault",
+			rule: &MessageRule{Name: NewRuleName(2500, "foo")},
+			want: RuleMetadata{
+				Name: "cloud::2500::foo",
+				AIP:  2500,
+				URL:  "https://linter.aip.dev/2500/foo",
+			},
+		},
+		{
+			name: "Undescribed",
+			rule: undescribedRule{name: "my-namespace::my-rule"},
+			want: RuleMetadata{
+				Name:             "my-namespace::my-rule",
+				EnabledByDefault: true,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := GetRuleMetadata(test.rule); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Got %+v, expected %+v.", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RuleOptions are the options of a rule set in the configs, as decoded from
+// YAML or JSON.
+type RuleOptions map[string]interface{}
+
+// ConfigurableRule is a ProtoRule whose behavior can be changed with the
+// options set in the configs (see Config.RuleOptions).
+type ConfigurableRule interface {
+	ProtoRule
+
+	// WithOptions returns the rule to run with the given options, or an error
+	// if the options are not valid. The returned rule has the same name.
+	WithOptions(RuleOptions) (ProtoRule, error)
+}
+
+// OptionsRule is a ConfigurableRule built from typed options.
+//
+// The options set in the configs are decoded as JSON into a copy of the
+// default options, so the options are named after the `json` tags of the
+// fields of T, and the options that are not set keep their default values.
+// Unknown options are an error.
+type OptionsRule[T any] struct {
+	// Defaults are the options of the rule when the configs do not set any.
+	Defaults T
+
+	// New returns the rule to run with the given options.
+	New func(T) ProtoRule
+
+	defaultOnce sync.Once
+	defaultRule ProtoRule
+}
+
+func (r *OptionsRule[T]) rule() ProtoRule {
+	r.defaultOnce.Do(func() {
+		r.defaultRule = r.New(r.Defaults)
+	})
+	return r.defaultRule
+}
+
+// GetName returns the name of the rule.
+func (r *OptionsRule[T]) GetName() RuleName {
+	return r.rule().GetName()
+}
+
+// Lint runs the rule with the default options.
+func (r *OptionsRule[T]) Lint(fd protoreflect.FileDescriptor) []Problem {
+	return r.rule().Lint(fd)
+}
+
+// GetDescription returns the description of the rule.
+func (r *OptionsRule[T]) GetDescription() string {
+	if d, ok := r.rule().(DescribedRule); ok {
+		return d.GetDescription()
+	}
+	return ""
+}
+
+// IsFixable returns whether the problems of the rule may suggest a fix.
+func (r *OptionsRule[T]) IsFixable() bool {
+	if d, ok := r.rule().(DescribedRule); ok {
+		return d.IsFixable()
+	}
+	return false
+}
+
+// GetExamples returns the examples of the rule.
+func (r *OptionsRule[T]) GetExamples() []RuleExample {
+	if d, ok := r.rule().(DescribedRule); ok {
+		return d.GetExamples()
+	}
+	return nil
+}
+
+// WithOptions returns the rule to run with the given options.
+func (r *OptionsRule[T]) WithOptions(options RuleOptions) (ProtoRule, error) {
+	// Copy the defaults through JSON, so that decoding the options does not
+	// modify the slices and maps of the defaults.
+	b, err := json.Marshal(r.Defaults)
+	if err != nil {
+		return nil, err
+	}
+	var opts T
+	if err := json.Unmarshal(b, &opts); err != nil {
+		return nil, err
+	}
+
+	if b, err = json.Marshal(options); err != nil {
+		return nil, fmt.Errorf("invalid options for rule %q: %w", r.GetName(), err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&opts); err != nil {
+		return nil, fmt.Errorf("invalid options for rule %q: %w", r.GetName(), err)
+	}
+	return r.New(opts), nil
+}
+
+// configuredRule returns the rule with the given name, configured with the
+// options set in the configs for a file path.
+//
+// The rules are built once for each set of options, and reused.
+func (l *Linter) configuredRule(name RuleName, path string) (ProtoRule, error) {
+	rule := l.rules[name]
+	options := l.configs.RuleOptions(string(name), path)
+	if len(options) == 0 {
+		return rule, nil
+	}
+	cr, ok := rule.(ConfigurableRule)
+	if !ok {
+		return nil, fmt.Errorf("rule %q does not have options", name)
+	}
+
+	// Maps are marshaled with sorted keys, so that equal options share a
+	// key.
+	b, err := json.Marshal(options)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options for rule %q: %w", name, err)
+	}
+	key := string(name) + "\x00" + string(b)
+	if v, ok := l.configuredRules.Load(key); ok {
+		return v.(configuredRule).rule, v.(configuredRule).err
+	}
+	r, err := cr.WithOptions(options)
+	if err == nil && r.GetName() != name {
+		err = fmt.Errorf("rule %q returned a rule named %q for its options", name, r.GetName())
+	}
+	v, _ := l.configuredRules.LoadOrStore(key, configuredRule{r, err})
+	return v.(configuredRule).rule, v.(configuredRule).err
+}
+
+type configuredRule struct {
+	rule ProtoRule
+	err  error
+}
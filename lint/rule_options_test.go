@@ -0,0 +1,208 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+type testRuleOptions struct {
+	Words []string `json:"words"`
+	Limit int      `json:"limit"`
+}
+
+// newTestOptionsRule returns a rule that reports its options on each message,
+// and counts the rules that it builds.
+func newTestOptionsRule(built *int) *OptionsRule[testRuleOptions] {
+	return &OptionsRule[testRuleOptions]{
+		Defaults: testRuleOptions{Words: []string{"foo"}, Limit: 1},
+		New: func(opts testRuleOptions) ProtoRule {
+			*built++
+			return &MessageRule{
+				Name: NewRuleName(111, "test-rule"),
+				RuleInfo: RuleInfo{
+					Description: "A test rule.",
+					Fixable:     true,
+				},
+				LintMessage: func(m protoreflect.MessageDescriptor) []Problem {
+					return []Problem{{
+						Message:    strings.Join(opts.Words, ",") + "/" + strings.Repeat("x", opts.Limit),
+						Descriptor: m,
+					}}
+				},
+			}
+		},
+	}
+}
+
+func TestOptionsRule(t *testing.T) {
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:        proto.String("test.proto"),
+		MessageType: []*descriptorpb.DescriptorProto{{Name: proto.String("Foo")}},
+	}, nil)
+	if err != nil {
+		t.Fatalf("Failed to build the file descriptor: %v", err)
+	}
+	messages := func(rule ProtoRule) []string {
+		var got []string
+		for _, p := range rule.Lint(fd) {
+			got = append(got, p.Message)
+		}
+		return got
+	}
+
+	built := 0
+	rule := newTestOptionsRule(&built)
+	if got, want := rule.GetName(), NewRuleName(111, "test-rule"); got != want {
+		t.Errorf("Got name %q, expected %q.", got, want)
+	}
+	if m := GetRuleMetadata(rule); m.Description != "A test rule." || !m.Fixable {
+		t.Errorf("Got metadata %+v, expected the metadata of the default rule.", m)
+	}
+	if got, want := messages(rule), []string{"foo/x"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %v, expected %v.", got, want)
+	}
+
+	tests := []struct {
+		testName string
+		options  RuleOptions
+		want     []string
+		wantErr  string
+	}{
+		{"Empty", RuleOptions{}, []string{"foo/x"}, ""},
+		{"Some", RuleOptions{"limit": 3}, []string{"foo/xxx"}, ""},
+		{"All", RuleOptions{"words": []interface{}{"bar", "baz"}, "limit": 0}, []string{"bar,baz/"}, ""},
+		{"Unknown", RuleOptions{"wrods": []interface{}{"bar"}}, nil, `unknown field "wrods"`},
+		{"WrongType", RuleOptions{"limit": "three"}, nil, `invalid options for rule "core::0111::test-rule"`},
+	}
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			configured, err := rule.WithOptions(test.options)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("Got error %v, expected it to contain %q.", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := messages(configured); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Got %v, expected %v.", got, test.want)
+			}
+		})
+	}
+
+	// The defaults are left alone.
+	if want := (testRuleOptions{Words: []string{"foo"}, Limit: 1}); !reflect.DeepEqual(rule.Defaults, want) {
+		t.Errorf("Got defaults %v, expected %v.", rule.Defaults, want)
+	}
+}
+
+func TestLinter_RuleOptions(t *testing.T) {
+	newFile := func(name string) protoreflect.FileDescriptor {
+		fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+			Name:        proto.String(name),
+			MessageType: []*descriptorpb.DescriptorProto{{Name: proto.String("Foo")}},
+		}, nil)
+		if err != nil {
+			t.Fatalf("Failed to build the file descriptor: %v", err)
+		}
+		return fd
+	}
+	files := []protoreflect.FileDescriptor{newFile("a/a.proto"), newFile("a/b.proto"), newFile("b/c.proto")}
+
+	built := 0
+	rules := NewRuleRegistry()
+	if err := rules.Register(111, newTestOptionsRule(&built)); err != nil {
+		t.Fatal(err)
+	}
+	configs := Configs{
+		{RuleOptions: map[string]RuleOptions{"core::0111::test-rule": {"words": []interface{}{"bar"}}}},
+		{IncludedPaths: []string{"a/**"}, RuleOptions: map[string]RuleOptions{"CORE::0111::TEST-RULE": {"limit": 2}}},
+	}
+	resps, err := New(rules, configs).LintProtos(files...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string][]string{}
+	for _, resp := range resps {
+		for _, p := range resp.Problems {
+			got[resp.FilePath] = append(got[resp.FilePath], p.Message)
+		}
+	}
+	want := map[string][]string{
+		"a/a.proto": {"bar/xx"},
+		"a/b.proto": {"bar/xx"},
+		"b/c.proto": {"bar/x"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %v, expected %v.", got, want)
+	}
+	// The default rule, and one rule for each set of options.
+	if built != 3 {
+		t.Errorf("Got %d rules built, expected 3.", built)
+	}
+
+	// Invalid options are an error.
+	configs = Configs{{RuleOptions: map[string]RuleOptions{"core::0111::test-rule": {"wrods": 1}}}}
+	if _, err := New(rules, configs).LintProtos(files[0]); err == nil || !strings.Contains(err.Error(), `unknown field "wrods"`) {
+		t.Errorf("Expected an error for unknown options, got %v", err)
+	}
+
+	// So are options for a rule that does not have any.
+	rules = NewRuleRegistry()
+	if err := rules.Register(111, &MessageRule{
+		Name:        NewRuleName(111, "test-rule"),
+		LintMessage: func(protoreflect.MessageDescriptor) []Problem { return nil },
+	}); err != nil {
+		t.Fatal(err)
+	}
+	configs = Configs{{RuleOptions: map[string]RuleOptions{"core::0111::test-rule": {"limit": 2}}}}
+	if _, err := New(rules, configs).LintProtos(files[0]); err == nil || !strings.Contains(err.Error(), "does not have options") {
+		t.Errorf("Expected an error for the options of a rule without options, got %v", err)
+	}
+}
+
+func TestReadConfigsRuleOptions(t *testing.T) {
+	content := `
+- rule_options:
+    core::0140::reserved-words:
+      additional_words: [foo]
+      allowed_words: [bar, baz]
+`
+	configs, err := ReadConfigsYAML(strings.NewReader(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := configs.RuleOptions("core::0140::reserved-words", "a.proto")
+	want := RuleOptions{
+		"additional_words": []interface{}{"foo"},
+		"allowed_words":    []interface{}{"bar", "baz"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %v, expected %v.", got, want)
+	}
+	if got := configs.RuleOptions("core::0140::abbreviations", "a.proto"); got != nil {
+		t.Errorf("Got %v, expected no options.", got)
+	}
+}
@@ -50,6 +50,39 @@ func (r RuleRegistry) Register(aip int, rules ...ProtoRule) error {
 	return nil
 }
 
+// RegisterCustom registers rules defined in configs, which must be in
+// CustomRuleGroup. Return an error if any of the rules is found duplicate in
+// the registry.
+func (r RuleRegistry) RegisterCustom(rules ...ProtoRule) error {
+	return r.registerInGroup(CustomRuleGroup, rules)
+}
+
+// RegisterPlugin registers rules provided by plugins, which must be in
+// PluginRuleGroup. Return an error if any of the rules is found duplicate in
+// the registry.
+func (r RuleRegistry) RegisterPlugin(rules ...ProtoRule) error {
+	return r.registerInGroup(PluginRuleGroup, rules)
+}
+
+func (r RuleRegistry) registerInGroup(group string, rules []ProtoRule) error {
+	for _, rl := range rules {
+		if !rl.GetName().IsValid() {
+			return fmt.Errorf("%q: %w", rl.GetName(), errInvalidRuleName)
+		}
+
+		if !rl.GetName().HasPrefix(group) {
+			return fmt.Errorf("%q: %w", rl.GetName(), errInvalidRuleGroup)
+		}
+
+		if _, found := r[rl.GetName()]; found {
+			return fmt.Errorf("%q: %w", rl.GetName(), errDuplicatedRuleName)
+		}
+
+		r[rl.GetName()] = rl
+	}
+	return nil
+}
+
 // NewRuleRegistry creates a new rule registry.
 func NewRuleRegistry() RuleRegistry {
 	return make(RuleRegistry)
@@ -15,6 +15,7 @@
 package lint
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -506,6 +507,69 @@ func TestDescriptorRule(t *testing.T) {
 	}
 }
 
+func TestAPIRule(t *testing.T) {
+	var files []protoreflect.FileDescriptor
+	for _, name := range []string{"book.proto", "shelf.proto"} {
+		fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+			Name: proto.String(name),
+		}, nil)
+		if err != nil {
+			t.Fatalf("Could not build file descriptor: %q", err)
+		}
+		files = append(files, fd)
+	}
+
+	var got []string
+	rule := &APIRule{
+		Name: RuleName("test"),
+		OnlyIf: func(fd protoreflect.FileDescriptor) bool {
+			return fd.Path() == "shelf.proto"
+		},
+		LintFiles: func(files []protoreflect.FileDescriptor) []Problem {
+			got = nil
+			for _, fd := range files {
+				got = append(got, fd.Path())
+			}
+			return nil
+		},
+	}
+	if got, want := rule.GetName(), "test"; string(got) != want {
+		t.Errorf("Got name %q, wanted %q", got, want)
+	}
+
+	// Only the applicable files are linted.
+	rule.LintAPI(files)
+	if want := []string{"shelf.proto"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got files %v, wanted %v", got, want)
+	}
+
+	// A single file is linted as an API of its own.
+	got = nil
+	rule.Lint(files[0])
+	if got != nil {
+		t.Errorf("Got files %v, wanted none", got)
+	}
+	rule.Lint(files[1])
+	if want := []string{"shelf.proto"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got files %v, wanted %v", got, want)
+	}
+
+	// The error of a rule that fails to lint is returned by LintAPI, and
+	// reported as a problem on the file by Lint.
+	failing := &APIRule{
+		Name: RuleName("test"),
+		TryLintFiles: func([]protoreflect.FileDescriptor) ([]Problem, error) {
+			return nil, errors.New("failed to lint")
+		},
+	}
+	if _, err := failing.LintAPI(files); err == nil || err.Error() != "failed to lint" {
+		t.Errorf("Got error %v, wanted the error of the rule", err)
+	}
+	if got, want := failing.Lint(files[0]), []Problem{{Message: "failed to lint", Descriptor: files[0]}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got problems %v, wanted %v", got, want)
+	}
+}
+
 type lintRuleTest struct {
 	testName string
 	problems []Problem
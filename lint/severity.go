@@ -0,0 +1,76 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Severity describes how serious a Problem is.
+//
+// Severities are assigned to problems by the user configuration, which
+// allows new rules to be rolled out as warnings before they are enforced.
+type Severity string
+
+const (
+	// SeverityError is the default severity of a Problem.
+	SeverityError Severity = "error"
+	// SeverityWarning marks a Problem that should be fixed, but that does
+	// not need to fail a build.
+	SeverityWarning Severity = "warning"
+	// SeverityInfo marks a Problem that is purely informational.
+	SeverityInfo Severity = "info"
+)
+
+// severityRanks orders the severities from the least to the most serious.
+var severityRanks = map[Severity]int{
+	SeverityInfo:    1,
+	SeverityWarning: 2,
+	SeverityError:   3,
+}
+
+// ParseSeverity returns the Severity with the given (case-insensitive) name.
+func ParseSeverity(s string) (Severity, error) {
+	sev := Severity(strings.ToLower(strings.TrimSpace(s)))
+	if _, ok := severityRanks[sev]; !ok {
+		return "", fmt.Errorf("invalid severity %q: must be one of %q, %q or %q", s, SeverityError, SeverityWarning, SeverityInfo)
+	}
+	return sev, nil
+}
+
+// AtLeast returns true if s is at least as serious as other.
+//
+// An unset severity is treated as SeverityError.
+func (s Severity) AtLeast(other Severity) bool {
+	return severityRanks[s.orDefault()] >= severityRanks[other.orDefault()]
+}
+
+// UnmarshalText validates a Severity read from a configuration file.
+func (s *Severity) UnmarshalText(text []byte) error {
+	sev, err := ParseSeverity(string(text))
+	if err != nil {
+		return err
+	}
+	*s = sev
+	return nil
+}
+
+func (s Severity) orDefault() Severity {
+	if s == "" {
+		return SeverityError
+	}
+	return s
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import "testing"
+
+func TestParseSeverity(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Severity
+		wantErr bool
+	}{
+		{"error", SeverityError, false},
+		{"WARNING", SeverityWarning, false},
+		{" info ", SeverityInfo, false},
+		{"fatal", "", true},
+		{"", "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			got, err := ParseSeverity(test.in)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("ParseSeverity(%q) got error %v, want error %v", test.in, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("ParseSeverity(%q) = %q, want %q", test.in, got, test.want)
+			}
+		})
+	}
+}
+
+func TestSeverityAtLeast(t *testing.T) {
+	tests := []struct {
+		s, other Severity
+		want     bool
+	}{
+		{SeverityError, SeverityWarning, true},
+		{SeverityWarning, SeverityWarning, true},
+		{SeverityInfo, SeverityWarning, false},
+		{"", SeverityError, true},
+		{SeverityWarning, "", false},
+	}
+	for _, test := range tests {
+		if got := test.s.AtLeast(test.other); got != test.want {
+			t.Errorf("%q.AtLeast(%q) = %v, want %v", test.s, test.other, got, test.want)
+		}
+	}
+}
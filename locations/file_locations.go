@@ -54,6 +54,15 @@ func FileJavaPackage(f protoreflect.FileDescriptor) *dpb.SourceCodeInfo_Location
 	return pathLocation(f, 8, 1) // 8 == options, 1 == java_package
 }
 
+// FileJavaOuterClassname returns the location of the java_outer_classname
+// file option in a file descriptor.
+//
+// If the location can not be found (for example, because there is no
+// java_outer_classname option), it returns nil.
+func FileJavaOuterClassname(f protoreflect.FileDescriptor) *dpb.SourceCodeInfo_Location {
+	return pathLocation(f, 8, 8) // 8 == options, 8 == java_outer_classname
+}
+
 // FilePhpNamespace returns the location of the php_namespace file option
 // in a file descriptor.
 //
@@ -39,6 +39,7 @@ func TestLocations(t *testing.T) {
 		option php_namespace = "Google\\Api\\Linter";
 		option ruby_package = "Google::Api::Linter";
 		option cc_enable_arenas = false;
+		option java_outer_classname = "LinterProto";
 
 		message Foo {
 			string bar = 1;
@@ -74,6 +75,11 @@ func TestLocations(t *testing.T) {
 				fx:       FileJavaPackage,
 				wantSpan: []int32{8, 0, int32(len(`option java_package = "com.google.api.linter";`))},
 			},
+			{
+				testName: "JavaOuterClassname",
+				fx:       FileJavaOuterClassname,
+				wantSpan: []int32{12, 0, int32(len(`option java_outer_classname = "LinterProto";`))},
+			},
 			{
 				testName: "PhpNamespace",
 				fx:       FilePhpNamespace,
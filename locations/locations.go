@@ -41,22 +41,14 @@ func pathLocation(d protoreflect.Descriptor, path ...int) *dpb.SourceCodeInfo_Lo
 }
 
 type sourceInfo struct {
-	// infoMu protects the info map
-	infoMu sync.Mutex
-	info   map[string]*dpb.SourceCodeInfo_Location
-}
-
-func newSourceInfo() *sourceInfo {
-	return &sourceInfo{
-		info: map[string]*dpb.SourceCodeInfo_Location{},
-	}
+	// once guards the computation of the info map, which is read-only
+	// afterwards.
+	once sync.Once
+	info map[string]*dpb.SourceCodeInfo_Location
 }
 
 // findLocation returns the Location for a given path.
 func (si *sourceInfo) findLocation(path []int32) *dpb.SourceCodeInfo_Location {
-	si.infoMu.Lock()
-	defer si.infoMu.Unlock()
-
 	// If the path exists in the source info registry, return that object.
 	if loc, ok := si.info[strPath(path)]; ok {
 		return loc
@@ -96,23 +88,25 @@ func strPath(segments []int32) (p string) {
 // sourceInfo compiles the source info object for a given file descriptor.
 // It also caches this into a registry, so subsequent calls using the same
 // descriptor will return the same object.
+//
+// The registry is only locked to find the entry of the file, so that
+// source maps of different files can be compiled concurrently.
 func (sir *sourceInfoRegistryType) sourceInfo(fd protoreflect.FileDescriptor) *sourceInfo {
 	sir.registryMu.Lock()
-	defer sir.registryMu.Unlock()
 	answer, ok := sir.registry[fd]
 	if !ok {
-		answer = newSourceInfo()
+		answer = &sourceInfo{}
+		sir.registry[fd] = answer
+	}
+	sir.registryMu.Unlock()
 
-		// This file descriptor does not yet have a source info map.
-		// Compile one.
+	// Compile the source info map the first time the file is seen.
+	answer.once.Do(func() {
+		answer.info = map[string]*dpb.SourceCodeInfo_Location{}
 		for _, loc := range protodesc.ToFileDescriptorProto(fd).GetSourceCodeInfo().GetLocation() {
 			answer.info[strPath(loc.Path)] = loc
 		}
-
-		// Now that we calculated all of this, cache it on the registry so it
-		// does not need to be calculated again.
-		sir.registry[fd] = answer
-	}
+	})
 	return answer
 }
 
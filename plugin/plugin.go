@@ -0,0 +1,250 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/googleapis/api-linter/v2/lint"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Plugin is a plugin executable, along with the rules that it provides.
+type Plugin struct {
+	path    string
+	configs lint.Configs
+	rules   []Rule
+
+	mu   sync.Mutex
+	runs map[string]*run
+}
+
+// maxRuns is the number of results that a plugin keeps, so that a long-lived
+// linter does not keep the results of every file that it ever linted.
+const maxRuns = 100
+
+// run is the result of a plugin on an API, shared by the rules of the plugin.
+type run struct {
+	once     sync.Once
+	problems map[lint.RuleName][]lint.Problem
+	err      error
+}
+
+// Load runs the plugin executable at the given path to describe its rules.
+// The configs are sent to the plugin along with every request.
+func Load(path string, configs lint.Configs) (*Plugin, error) {
+	p := &Plugin{path: path, configs: configs, runs: map[string]*run{}}
+	resp, err := p.call(&Request{Method: MethodDescribe})
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range resp.Rules {
+		if !lint.RuleName(r.Name).HasPrefix(lint.PluginRuleGroup) {
+			return nil, fmt.Errorf("plugin %s: rule %q is not in the %q group", path, r.Name, lint.PluginRuleGroup)
+		}
+	}
+	p.rules = resp.Rules
+	return p, nil
+}
+
+// Rules returns the rules of the plugin, to register with
+// lint.RuleRegistry.RegisterPlugin.
+//
+// The rules lint the files of an API together. The plugin runs once for each
+// API, and an error of the plugin is reported as an error of each of its
+// rules.
+func (p *Plugin) Rules() []lint.ProtoRule {
+	var rules []lint.ProtoRule
+	for _, r := range p.rules {
+		name := lint.RuleName(r.Name)
+		rules = append(rules, &lint.APIRule{
+			Name: name,
+			RuleInfo: lint.RuleInfo{
+				Description: r.Description,
+				Fixable:     r.Fixable,
+			},
+			TryLintFiles: func(files []protoreflect.FileDescriptor) ([]lint.Problem, error) {
+				problems, err := p.lint(files)
+				if err != nil {
+					return nil, err
+				}
+				return problems[name], nil
+			},
+		})
+	}
+	return rules
+}
+
+// lint runs the plugin on the files of an API, once.
+func (p *Plugin) lint(files []protoreflect.FileDescriptor) (map[lint.RuleName][]lint.Problem, error) {
+	fds, err := proto.MarshalOptions{Deterministic: true}.Marshal(fileDescriptorSet(files))
+	if err != nil {
+		return nil, err
+	}
+	// The runs are keyed by the contents of the files, since the same paths
+	// may be linted again with different contents, such as by the language
+	// server.
+	var paths []string
+	for _, fd := range files {
+		paths = append(paths, fd.Path())
+	}
+	sum := sha256.Sum256(fds)
+	key := strings.Join(paths, ",") + ":" + hex.EncodeToString(sum[:])
+	p.mu.Lock()
+	r, ok := p.runs[key]
+	if !ok {
+		if len(p.runs) >= maxRuns {
+			p.runs = map[string]*run{}
+		}
+		r = &run{}
+		p.runs[key] = r
+	}
+	p.mu.Unlock()
+
+	r.once.Do(func() {
+		r.problems, r.err = p.lintOnce(files, paths, fds)
+	})
+	return r.problems, r.err
+}
+
+func (p *Plugin) lintOnce(files []protoreflect.FileDescriptor, paths []string, fds []byte) (map[lint.RuleName][]lint.Problem, error) {
+	resp, err := p.call(&Request{
+		Method:            MethodLint,
+		FileDescriptorSet: fds,
+		FilesToLint:       paths,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Look up the descriptors of the problems in the files of the API.
+	reg := &protoregistry.Files{}
+	for _, fd := range files {
+		if err := reg.RegisterFile(fd); err != nil {
+			return nil, err
+		}
+	}
+	rules := map[lint.RuleName]bool{}
+	for _, r := range p.rules {
+		rules[lint.RuleName(r.Name)] = true
+	}
+	problems := map[lint.RuleName][]lint.Problem{}
+	for _, pp := range resp.Problems {
+		name := lint.RuleName(pp.RuleID)
+		if !rules[name] {
+			return nil, fmt.Errorf("plugin %s: problem of unknown rule %q", p.path, pp.RuleID)
+		}
+		problem, err := toProblem(pp, files, reg)
+		if err != nil {
+			return nil, fmt.Errorf("plugin %s: rule %q: %w", p.path, pp.RuleID, err)
+		}
+		problems[name] = append(problems[name], problem)
+	}
+	return problems, nil
+}
+
+// call sends a request to the plugin, and returns its response.
+func (p *Plugin) call(req *Request) (*Response, error) {
+	req.ProtocolVersion = ProtocolVersion
+	req.Configs = p.configs
+	in, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	cmd := exec.Command(p.path)
+	cmd.Stdin = bytes.NewReader(in)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s: %v: %s", p.path, err, bytes.TrimSpace(stderr.Bytes()))
+	}
+
+	resp := &Response{}
+	if err := json.Unmarshal(out, resp); err != nil {
+		return nil, fmt.Errorf("plugin %s: invalid response: %w", p.path, err)
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("plugin %s: %s", p.path, resp.Error)
+	}
+	if resp.ProtocolVersion != ProtocolVersion {
+		return nil, fmt.Errorf("plugin %s: unsupported protocol version %d, expected %d", p.path, resp.ProtocolVersion, ProtocolVersion)
+	}
+	return resp, nil
+}
+
+// toProblem returns the lint problem for a problem found by a plugin, with
+// its descriptor looked up in the files of the API.
+func toProblem(pp Problem, files []protoreflect.FileDescriptor, reg *protoregistry.Files) (lint.Problem, error) {
+	problem := lint.Problem{Message: pp.Message, Suggestion: pp.Suggestion}
+	if pp.Descriptor == "" {
+		for _, fd := range files {
+			if fd.Path() == pp.File {
+				problem.Descriptor = fd
+			}
+		}
+		if problem.Descriptor == nil {
+			return problem, fmt.Errorf("file %q is not linted", pp.File)
+		}
+	} else {
+		d, err := reg.FindDescriptorByName(protoreflect.FullName(pp.Descriptor))
+		if err != nil || (pp.File != "" && d.ParentFile().Path() != pp.File) {
+			return problem, fmt.Errorf("descriptor %q not found", pp.Descriptor)
+		}
+		problem.Descriptor = d
+	}
+
+	switch len(pp.Span) {
+	case 0:
+	case 3, 4:
+		problem.Location = &dpb.SourceCodeInfo_Location{Span: pp.Span}
+	default:
+		return problem, fmt.Errorf("invalid span %v", pp.Span)
+	}
+	return problem, nil
+}
+
+// fileDescriptorSet returns a FileDescriptorSet with the files and their
+// dependencies, in dependency order.
+func fileDescriptorSet(files []protoreflect.FileDescriptor) *dpb.FileDescriptorSet {
+	fds := &dpb.FileDescriptorSet{}
+	seen := map[string]bool{}
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		for i := 0; i < fd.Imports().Len(); i++ {
+			add(fd.Imports().Get(i).FileDescriptor)
+		}
+		fds.File = append(fds.File, protodesc.ToFileDescriptorProto(fd))
+	}
+	for _, fd := range files {
+		add(fd)
+	}
+	return fds
+}
@@ -0,0 +1,252 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/googleapis/api-linter/v2/lint"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// The test binary acts as the plugin when this variable is set to one of the
+// modes of testPlugin.
+const modeEnv = "API_LINTER_TEST_PLUGIN"
+
+// logEnv is the path of a file where the test plugin logs the files that it
+// lints.
+const logEnv = "API_LINTER_TEST_PLUGIN_LOG"
+
+func TestMain(m *testing.M) {
+	if mode := os.Getenv(modeEnv); mode != "" {
+		if err := testPlugin(mode); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// testPlugin reports the messages whose name does not end in "Message", and
+// the files without a package.
+func testPlugin(mode string) error {
+	var req Request
+	if err := json.NewDecoder(os.Stdin).Decode(&req); err != nil {
+		return err
+	}
+	resp := Response{ProtocolVersion: ProtocolVersion}
+	switch mode {
+	case "crash":
+		return fmt.Errorf("plugin crashed")
+	case "error":
+		resp.Error = "something went wrong"
+	case "version":
+		resp.ProtocolVersion = ProtocolVersion + 1
+	case "bad-rule":
+		resp.Rules = []Rule{{Name: "core::0001::bad-rule"}}
+	}
+	if mode != "ok" && mode != "unknown-descriptor" {
+		return json.NewEncoder(os.Stdout).Encode(resp)
+	}
+
+	switch req.Method {
+	case MethodDescribe:
+		resp.Rules = []Rule{
+			{Name: "plugin::test::message-suffix", Description: "Messages must end in Message.", Fixable: true},
+			{Name: "plugin::test::package"},
+		}
+	case MethodLint:
+		if path := os.Getenv(logEnv); path != "" {
+			f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(f, strings.Join(req.FilesToLint, ","))
+			f.Close()
+		}
+		fds := &dpb.FileDescriptorSet{}
+		if err := proto.Unmarshal(req.FileDescriptorSet, fds); err != nil {
+			return err
+		}
+		for _, f := range fds.GetFile() {
+			if !contains(req.FilesToLint, f.GetName()) {
+				continue
+			}
+			if f.GetPackage() == "" {
+				resp.Problems = append(resp.Problems, Problem{RuleID: "plugin::test::package", Message: "No package.", File: f.GetName()})
+			}
+			for _, m := range f.GetMessageType() {
+				if !strings.HasSuffix(m.GetName(), "Message") {
+					name := m.GetName()
+					if f.GetPackage() != "" {
+						name = f.GetPackage() + "." + name
+					}
+					if mode == "unknown-descriptor" {
+						name += "Unknown"
+					}
+					resp.Problems = append(resp.Problems, Problem{
+						RuleID:     "plugin::test::message-suffix",
+						Message:    "Bad name.",
+						Descriptor: name,
+						Span:       []int32{1, 2, 3},
+						Suggestion: m.GetName() + "Message",
+					})
+				}
+			}
+		}
+	}
+	return json.NewEncoder(os.Stdout).Encode(resp)
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func newFile(t *testing.T, name, pkg string, messages ...string) protoreflect.FileDescriptor {
+	t.Helper()
+	fdp := &dpb.FileDescriptorProto{Name: proto.String(name)}
+	if pkg != "" {
+		fdp.Package = proto.String(pkg)
+	}
+	for _, m := range messages {
+		fdp.MessageType = append(fdp.MessageType, &dpb.DescriptorProto{Name: proto.String(m)})
+	}
+	fd, err := protodesc.NewFile(fdp, nil)
+	if err != nil {
+		t.Fatalf("Failed to build the file descriptor: %v", err)
+	}
+	return fd
+}
+
+func loadTestPlugin(t *testing.T, mode string, configs lint.Configs) (*Plugin, error) {
+	t.Helper()
+	t.Setenv(modeEnv, mode)
+	return Load(os.Args[0], configs)
+}
+
+func TestPlugin(t *testing.T) {
+	log := t.TempDir() + "/log"
+	t.Setenv(logEnv, log)
+	configs := lint.Configs{{DisabledRules: []string{"plugin::test::package"}, IncludedPaths: []string{"b.proto"}}}
+	p, err := loadTestPlugin(t, "ok", configs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rules := lint.NewRuleRegistry()
+	if err := rules.RegisterPlugin(p.Rules()...); err != nil {
+		t.Fatal(err)
+	}
+	if m := lint.GetRuleMetadata(rules["plugin::test::message-suffix"]); m.Description != "Messages must end in Message." || !m.Fixable {
+		t.Errorf("Got metadata %+v, expected the description of the plugin.", m)
+	}
+
+	files := []protoreflect.FileDescriptor{
+		newFile(t, "a.proto", "test.v1", "Foo", "BarMessage"),
+		newFile(t, "b.proto", "", "Baz"),
+		newFile(t, "c.proto", "test.v1", "Qux"),
+	}
+	resps, err := lint.New(rules, configs).LintProtos(files...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string][]string{}
+	for _, resp := range resps {
+		for _, problem := range resp.Problems {
+			got[resp.FilePath] = append(got[resp.FilePath], fmt.Sprintf("%s %s %s %v %s", problem.RuleID, problem.Descriptor.FullName(), problem.Message, problem.Location.GetSpan(), problem.Suggestion))
+		}
+	}
+	want := map[string][]string{
+		"a.proto": {"plugin::test::message-suffix test.v1.Foo Bad name. [1 2 3] FooMessage"},
+		"b.proto": {"plugin::test::message-suffix Baz Bad name. [1 2 3] BazMessage"},
+		"c.proto": {"plugin::test::message-suffix test.v1.Qux Bad name. [1 2 3] QuxMessage"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %v, want %v.", got, want)
+	}
+
+	// The plugin runs once for each API.
+	b, err := os.ReadFile(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.Fields(string(b)), []string{"a.proto,c.proto", "b.proto"}; len(got) != 2 || !contains(got, want[0]) || !contains(got, want[1]) {
+		t.Errorf("Got plugin runs %v, want %v.", got, want)
+	}
+
+	// The results are kept for the same contents, even in new descriptors,
+	// but not for new contents of the same files.
+	if err := os.Remove(log); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := lint.New(rules, configs).LintProtos(newFile(t, "b.proto", "", "Baz")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := lint.New(rules, configs).LintProtos(newFile(t, "b.proto", "", "Baz", "Qux")); err != nil {
+		t.Fatal(err)
+	}
+	if b, err = os.ReadFile(log); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.Fields(string(b)), []string{"b.proto"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got plugin runs %v, want %v.", got, want)
+	}
+}
+
+func TestPluginErrors(t *testing.T) {
+	for _, test := range []struct {
+		mode string
+		want string
+	}{
+		{"crash", "plugin crashed"},
+		{"error", "something went wrong"},
+		{"version", "unsupported protocol version 2"},
+		{"bad-rule", `rule "core::0001::bad-rule" is not in the "plugin" group`},
+	} {
+		t.Run(test.mode, func(t *testing.T) {
+			if _, err := loadTestPlugin(t, test.mode, nil); err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("Got error %v, want it to contain %q.", err, test.want)
+			}
+		})
+	}
+
+	// Errors while linting are reported by the linter.
+	p, err := loadTestPlugin(t, "unknown-descriptor", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules := lint.NewRuleRegistry()
+	if err := rules.RegisterPlugin(p.Rules()...); err != nil {
+		t.Fatal(err)
+	}
+	_, err = lint.New(rules, nil).LintProtos(newFile(t, "a.proto", "test.v1", "Foo"))
+	if want := `descriptor "test.v1.FooUnknown" not found`; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Got error %v, want it to contain %q.", err, want)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package plugin runs lint rules provided by external executables.
+//
+// A plugin is an executable that reads a JSON Request from its standard
+// input, writes a JSON Response to its standard output, and exits. The linter
+// runs each plugin once to describe its rules, then once for each API that it
+// lints. Plugins can be written in any language.
+package plugin
+
+import "github.com/googleapis/api-linter/v2/lint"
+
+// ProtocolVersion is the version of the protocol between the linter and the
+// plugins. It changes when the protocol changes in an incompatible way.
+const ProtocolVersion = 1
+
+// The methods of a Request.
+const (
+	// MethodDescribe asks the plugin to describe its rules.
+	MethodDescribe = "describe"
+
+	// MethodLint asks the plugin to lint files.
+	MethodLint = "lint"
+)
+
+// Request is the request sent to a plugin.
+type Request struct {
+	// ProtocolVersion is the version of the protocol used by the linter.
+	ProtocolVersion int `json:"protocol_version"`
+
+	// Method is either MethodDescribe or MethodLint.
+	Method string `json:"method"`
+
+	// FileDescriptorSet is a serialized google.protobuf.FileDescriptorSet
+	// (base64 encoded in JSON), with the files to lint and all of their
+	// dependencies, in dependency order. Only set for MethodLint.
+	FileDescriptorSet []byte `json:"file_descriptor_set,omitempty"`
+
+	// FilesToLint are the paths of the files to lint, which make up one API.
+	// Only set for MethodLint.
+	FilesToLint []string `json:"files_to_lint,omitempty"`
+
+	// Configs are the configs of the linter.
+	Configs lint.Configs `json:"configs,omitempty"`
+}
+
+// Response is the response of a plugin.
+type Response struct {
+	// ProtocolVersion is the version of the protocol used by the plugin,
+	// which must match the version of the linter.
+	ProtocolVersion int `json:"protocol_version"`
+
+	// Rules are the rules of the plugin, in response to MethodDescribe.
+	Rules []Rule `json:"rules,omitempty"`
+
+	// Problems are the problems found, in response to MethodLint.
+	Problems []Problem `json:"problems,omitempty"`
+
+	// Error is set if the plugin failed.
+	Error string `json:"error,omitempty"`
+}
+
+// Rule describes a rule of a plugin.
+type Rule struct {
+	// Name is the full name of the rule, which must be in the
+	// lint.PluginRuleGroup group, such as `plugin::acme::0001::resource-owner`.
+	Name string `json:"name"`
+
+	// Description is a short description of the rule. Optional.
+	Description string `json:"description,omitempty"`
+
+	// Fixable is whether the problems of the rule may suggest a fix.
+	Fixable bool `json:"fixable,omitempty"`
+}
+
+// Problem is a problem found by a plugin.
+type Problem struct {
+	// RuleID is the name of the rule that found the problem.
+	RuleID string `json:"rule_id"`
+
+	// Message is a short description of the problem.
+	Message string `json:"message"`
+
+	// File is the path of the file of the problem. Required if Descriptor
+	// is not set, in which case the problem is about the whole file.
+	File string `json:"file,omitempty"`
+
+	// Descriptor is the full name of the descriptor of the problem, such as
+	// `acme.library.v1.Book.name`.
+	Descriptor string `json:"descriptor,omitempty"`
+
+	// Span is the location of the problem, in the format of the spans of
+	// google.protobuf.SourceCodeInfo: zero-based [start line, start column,
+	// end line, end column], or [line, start column, end column]. Optional;
+	// defaults to the location of the descriptor.
+	Span []int32 `json:"span,omitempty"`
+
+	// Suggestion is the text to replace the span with, if applicable.
+	Suggestion string `json:"suggestion,omitempty"`
+}
@@ -25,7 +25,10 @@ import (
 )
 
 var noMutableCycles = &lint.MessageRule{
-	Name:   lint.NewRuleName(121, "no-mutable-cycles"),
+	Name: lint.NewRuleName(121, "no-mutable-cycles"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resources must not form a resource reference cycle.",
+	},
 	OnlyIf: utils.IsResource,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		res := utils.GetResource(m)
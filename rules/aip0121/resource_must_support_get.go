@@ -25,6 +25,9 @@ import (
 
 var resourceMustSupportGet = &lint.ServiceRule{
 	Name: lint.NewRuleName(121, "resource-must-support-get"),
+	RuleInfo: lint.RuleInfo{
+		Description: "All resources must have a Standard Get method.",
+	},
 	LintService: func(s protoreflect.ServiceDescriptor) []lint.Problem {
 		var problems []lint.Problem
 		var resourcesWithGet stringset.Set
@@ -25,6 +25,9 @@ import (
 
 var resourceMustSupportList = &lint.ServiceRule{
 	Name: lint.NewRuleName(121, "resource-must-support-list"),
+	RuleInfo: lint.RuleInfo{
+		Description: "All resources must have a Standard List method.",
+	},
 	LintService: func(s protoreflect.ServiceDescriptor) []lint.Problem {
 		var problems []lint.Problem
 		var resourcesWithList stringset.Set
@@ -26,6 +26,9 @@ import (
 // HTTP URL pattern shouldn't include underscore("_")
 var httpURICase = &lint.MethodRule{
 	Name: lint.NewRuleName(122, "camel-case-uris"),
+	RuleInfo: lint.RuleInfo{
+		Description: "All resource names must use camel case in collection identifiers.",
+	},
 	LintMethod: func(m protoreflect.MethodDescriptor) (problems []lint.Problem) {
 		// Establish that the URI does not include a `_` character.
 		for _, httpRule := range utils.GetHTTPRules(m) {
@@ -23,7 +23,11 @@ import (
 )
 
 var embeddedResource = &lint.MessageRule{
-	Name:   lint.NewRuleName(122, "embedded-resource"),
+	Name: lint.NewRuleName(122, "embedded-resource"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resource references should not be embedded resources.",
+		Fixable:     true,
+	},
 	OnlyIf: utils.IsResource,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		var problems []lint.Problem
@@ -25,6 +25,10 @@ import (
 
 var nameSuffix = &lint.FieldRule{
 	Name: lint.NewRuleName(122, "name-suffix"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Fields should not use the suffix `_name`.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		n := string(f.Name())
 		// Ignore `{prefix}_display_name` fields as this seems like a reasonable suffix.
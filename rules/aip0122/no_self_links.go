@@ -21,7 +21,10 @@ import (
 )
 
 var noSelfLinks = &lint.MessageRule{
-	Name:   lint.NewRuleName(122, "no-self-links"),
+	Name: lint.NewRuleName(122, "no-self-links"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resources should not contain self-links.",
+	},
 	OnlyIf: utils.IsResource,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		problems := []lint.Problem{}
@@ -29,6 +29,9 @@ var firstCharRegexp = regexp.MustCompile(`^[a-z]`)
 
 var resourceCollectionIdentifiers = &lint.MessageRule{
 	Name: lint.NewRuleName(122, "resource-collection-identifiers"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resource patterns must use lowerCamelCase for collection identifiers.",
+	},
 	OnlyIf: func(m protoreflect.MessageDescriptor) bool {
 		return utils.GetResource(m) != nil
 	},
@@ -24,6 +24,9 @@ import (
 
 var resourceIDOutputOnly = &lint.FieldRule{
 	Name: lint.NewRuleName(122, "resource-id-output-only"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resource ID fields must be classified as `OUTPUT_ONLY`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		var idName string
 		p := f.Parent().(protoreflect.MessageDescriptor)
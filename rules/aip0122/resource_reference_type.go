@@ -23,6 +23,9 @@ import (
 
 var resourceReferenceType = &lint.FieldRule{
 	Name: lint.NewRuleName(122, "resource-reference-type"),
+	RuleInfo: lint.RuleInfo{
+		Description: "All resource references must be strings.",
+	},
 	LintField: func(f protoreflect.FieldDescriptor) []lint.Problem {
 		if utils.GetResourceReference(f) != nil && utils.GetTypeName(f) != "string" {
 			return []lint.Problem{{
@@ -88,6 +88,9 @@ func allDeps(f protoreflect.FileDescriptor, deps map[string]protoreflect.FileDes
 
 var duplicateResource = &lint.FileRule{
 	Name: lint.NewRuleName(123, "duplicate-resource"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resource types should not be defined more than once.",
+	},
 	LintFile: func(f protoreflect.FileDescriptor) []lint.Problem {
 		defsInFile := resourceDefsInFile(f, map[string][]resourceDef{})
 		if len(defsInFile) == 0 {
@@ -19,10 +19,36 @@ import (
 	"github.com/googleapis/api-linter/v2/locations"
 	"github.com/googleapis/api-linter/v2/rules/internal/utils"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
 )
 
 var nameNeverOptional = &lint.MessageRule{
 	Name: lint.NewRuleName(123, "name-never-optional"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resource name fields must never be labeled with proto3_optional.",
+		Fixable:     true,
+		Examples: []lint.RuleExample{
+			{
+				Incorrect: `message Book {
+  option (google.api.resource) = {
+    type: "library.googleapis.com/Book"
+    pattern: "publishers/{publisher}/books/{book}"
+  };
+
+  // The name field should not be labeled as optional.
+  optional string name = 1;
+}`,
+				Correct: `message Book {
+  option (google.api.resource) = {
+    type: "library.googleapis.com/Book"
+    pattern: "publishers/{publisher}/books/{book}"
+  };
+
+  string name = 1;
+}`,
+			},
+		},
+	},
 	OnlyIf: func(m protoreflect.MessageDescriptor) bool {
 		// Skip check for proto2 where specifying the `required` or `optional`
 		// label is necessary, and where `optional` makes sense in that context.
@@ -48,10 +74,26 @@ var nameNeverOptional = &lint.MessageRule{
 				Message:    "Resource name fields must never be labeled with proto3_optional",
 				Descriptor: field,
 				Location:   locations.FieldLabel(field),
-				Suggestion: "",
+				Fixes:      removeOptionalFixes(field),
 			}}
 		}
 
 		return nil
 	},
 }
+
+// removeOptionalFixes returns a fix that removes the `optional` label of a
+// field, up to its type.
+func removeOptionalFixes(f protoreflect.FieldDescriptor) []lint.Fix {
+	label := locations.FieldLabel(f).GetSpan()
+	typ := locations.FieldType(f).GetSpan()
+	if len(label) < 3 || len(typ) < 3 || label[0] != typ[0] {
+		return nil
+	}
+	return []lint.Fix{{
+		Title: "Remove `optional`",
+		Edits: []lint.TextEdit{{
+			Location: &dpb.SourceCodeInfo_Location{Span: []int32{label[0], label[1], typ[1]}},
+		}},
+	}}
+}
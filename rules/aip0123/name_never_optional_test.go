@@ -17,6 +17,7 @@ package aip0123
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/googleapis/api-linter/v2/rules/internal/testutils"
 )
 
@@ -54,6 +55,34 @@ func TestNameNeverOptional(t *testing.T) {
 	}
 }
 
+func TestNameNeverOptionalFixes(t *testing.T) {
+	f := testutils.ParseProto3String(t, `
+		import "google/api/resource.proto";
+		message Book {
+			option (google.api.resource) = {
+				type: "library.googleapis.com/Book"
+				pattern: "publishers/{publisher}/books/{book}"
+			};
+			optional string name = 1;
+		}
+	`)
+	problems := nameNeverOptional.Lint(f)
+	if len(problems) != 1 || len(problems[0].Fixes) != 1 {
+		t.Fatalf("Expected one problem with one fix, got %v", problems)
+	}
+	edits := problems[0].Fixes[0].Edits
+	if len(edits) != 1 {
+		t.Fatalf("Expected one edit, got %d", len(edits))
+	}
+	// The label is removed along with the space before the type.
+	if diff := cmp.Diff([]int32{8, 8, 17}, edits[0].Location.GetSpan()); diff != "" {
+		t.Errorf("span mismatch (-want +got):\n%s", diff)
+	}
+	if got := edits[0].NewText; got != "" {
+		t.Errorf("Got new text %q, want none", got)
+	}
+}
+
 func TestNameNeverOptional_SkipProto2(t *testing.T) {
 	f := testutils.ParseProtoString(t, `
 		syntax = "proto2";
@@ -21,7 +21,10 @@ import (
 )
 
 var resourceAnnotation = &lint.MessageRule{
-	Name:   lint.NewRuleName(123, "resource-annotation"),
+	Name: lint.NewRuleName(123, "resource-annotation"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resource messages should be annotated with `google.api.resource`.",
+	},
 	OnlyIf: isResourceMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		if utils.GetResource(m) == nil {
@@ -22,7 +22,10 @@ import (
 )
 
 var resourceDefinitionPatterns = &lint.FileRule{
-	Name:   lint.NewRuleName(123, "resource-definition-pattern"),
+	Name: lint.NewRuleName(123, "resource-definition-pattern"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resource annotations should define a pattern.",
+	},
 	OnlyIf: hasResourceDefinitionAnnotation,
 	LintFile: func(f protoreflect.FileDescriptor) []lint.Problem {
 		var problems []lint.Problem
@@ -24,7 +24,10 @@ import (
 )
 
 var resourceDefinitionTypeName = &lint.FileRule{
-	Name:   lint.NewRuleName(123, "resource-definition-type-name"),
+	Name: lint.NewRuleName(123, "resource-definition-type-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resource type names must be of the form {Service Name}/{Type}.",
+	},
 	OnlyIf: hasResourceDefinitionAnnotation,
 	LintFile: func(f protoreflect.FileDescriptor) []lint.Problem {
 		var problems []lint.Problem
@@ -22,7 +22,10 @@ import (
 )
 
 var resourceDefinitionVariables = &lint.FileRule{
-	Name:   lint.NewRuleName(123, "resource-definition-variables"),
+	Name: lint.NewRuleName(123, "resource-definition-variables"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resource patterns should use consistent variable naming.",
+	},
 	OnlyIf: hasResourceDefinitionAnnotation,
 	LintFile: func(f protoreflect.FileDescriptor) []lint.Problem {
 		var problems []lint.Problem
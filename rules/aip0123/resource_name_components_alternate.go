@@ -28,7 +28,10 @@ import (
 var identifierRegexp = regexp.MustCompile("^{[a-z][_a-z0-9]*[a-z0-9]}$")
 
 var resourceNameComponentsAlternate = &lint.MessageRule{
-	Name:   lint.NewRuleName(123, "resource-name-components-alternate"),
+	Name: lint.NewRuleName(123, "resource-name-components-alternate"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resource name components should alternate between collection and identifiers.",
+	},
 	OnlyIf: utils.IsResource,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		var problems []lint.Problem
@@ -21,7 +21,11 @@ import (
 )
 
 var resourceNameField = &lint.MessageRule{
-	Name:   lint.NewRuleName(123, "resource-name-field"),
+	Name: lint.NewRuleName(123, "resource-name-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resource messages should have a `string name` field.",
+		Fixable:     true,
+	},
 	OnlyIf: utils.IsResource,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		f := "name"
@@ -27,7 +27,10 @@ import (
 )
 
 var resourcePattern = &lint.MessageRule{
-	Name:   lint.NewRuleName(123, "resource-pattern"),
+	Name: lint.NewRuleName(123, "resource-pattern"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resource annotations should define a pattern.",
+	},
 	OnlyIf: hasResourceAnnotation,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		resource := utils.GetResource(m)
@@ -26,6 +26,9 @@ import (
 
 var resourcePatternPlural = &lint.MessageRule{
 	Name: lint.NewRuleName(123, "resource-pattern-plural"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resource patterns must use the plural as the collection segment",
+	},
 	OnlyIf: func(m protoreflect.MessageDescriptor) bool {
 		return utils.IsResource(m) && len(utils.GetResource(m).GetPattern()) > 0 && utils.GetResourcePlural(utils.GetResource(m)) != "" && !utils.IsSingletonResource(m)
 	},
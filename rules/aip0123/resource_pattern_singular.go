@@ -27,6 +27,9 @@ import (
 
 var resourcePatternSingular = &lint.MessageRule{
 	Name: lint.NewRuleName(123, "resource-pattern-singular"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resource patterns must use the singular as the resource ID segment",
+	},
 	OnlyIf: func(m protoreflect.MessageDescriptor) bool {
 		return utils.IsResource(m) && len(utils.GetResource(m).GetPattern()) > 0
 	},
@@ -24,7 +24,10 @@ import (
 )
 
 var resourcePlural = &lint.MessageRule{
-	Name:   lint.NewRuleName(123, "resource-plural"),
+	Name: lint.NewRuleName(123, "resource-plural"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resource plural is required",
+	},
 	OnlyIf: hasResourceAnnotation,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		r := utils.GetResource(m)
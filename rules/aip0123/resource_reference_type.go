@@ -23,6 +23,10 @@ import (
 
 var resourceReferenceType = &lint.FieldRule{
 	Name: lint.NewRuleName(123, "resource-reference-type"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resource reference annotations should only apply to strings.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		return utils.GetResourceReference(f) != nil
 	},
@@ -24,7 +24,10 @@ import (
 )
 
 var resourceSingular = &lint.MessageRule{
-	Name:   lint.NewRuleName(123, "resource-singular"),
+	Name: lint.NewRuleName(123, "resource-singular"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resource singular is required and must be lowerCamelCase of type",
+	},
 	OnlyIf: hasResourceAnnotation,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		r := utils.GetResource(m)
@@ -25,7 +25,10 @@ import (
 )
 
 var resourceTypeMessage = &lint.MessageRule{
-	Name:   lint.NewRuleName(123, "resource-type-message"),
+	Name: lint.NewRuleName(123, "resource-type-message"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resource type names must match containing message name.",
+	},
 	OnlyIf: utils.IsResource,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		n := m.Name()
@@ -25,6 +25,9 @@ import (
 
 var resourceTypeName = &lint.MessageRule{
 	Name: lint.NewRuleName(123, "resource-type-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resource type names must be of the form {Service Name}/{Type}.",
+	},
 	OnlyIf: func(m protoreflect.MessageDescriptor) bool {
 		return utils.GetResource(m) != nil
 	},
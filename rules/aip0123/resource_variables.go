@@ -27,7 +27,10 @@ import (
 )
 
 var resourceVariables = &lint.MessageRule{
-	Name:   lint.NewRuleName(123, "resource-variables"),
+	Name: lint.NewRuleName(123, "resource-variables"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resource patterns should use consistent variable naming.",
+	},
 	OnlyIf: hasResourceAnnotation,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		resource := utils.GetResource(m)
@@ -31,29 +31,32 @@ func AddRules(r lint.RuleRegistry) error {
 	)
 }
 
-// isUnknownType returns true if and only if the type is not a common,
-// well known type.
-func isUnknownType(f protoreflect.FieldDescriptor) bool {
+// commonTypes are the resource types that are not checked, since they are
+// common to many APIs.
+var commonTypes = stringset.New(
+	// Allow the common resource types in GCP.
+	"cloudresourcemanager.googleapis.com/Project",
+	"cloudresourcemanager.googleapis.com/Organization",
+	"cloudresourcemanager.googleapis.com/Folder",
+	"billing.googleapis.com/BillingAccount",
+	"locations.googleapis.com/Location",
+
+	// Allow *.
+	"*",
+
+	// If no type is declared, ignore this.
+	"",
+)
+
+// isUnknownType returns true if and only if the field references a type
+// that is not one of the given common types.
+func isUnknownType(f protoreflect.FieldDescriptor, common stringset.Set) bool {
 	if ref := utils.GetResourceReference(f); ref != nil {
 		urt := ref.GetType()
 		if urt == "" {
 			urt = ref.GetChildType()
 		}
-		return !stringset.New(
-			// Allow the common resource types in GCP.
-			// FIXME: Modularize this.
-			"cloudresourcemanager.googleapis.com/Project",
-			"cloudresourcemanager.googleapis.com/Organization",
-			"cloudresourcemanager.googleapis.com/Folder",
-			"billing.googleapis.com/BillingAccount",
-			"locations.googleapis.com/Location",
-
-			// Allow *.
-			"*",
-
-			// If no type is declared, ignore this.
-			"",
-		).Contains(urt)
+		return !common.Contains(urt)
 	}
 	return false
 }
@@ -17,51 +17,70 @@ package aip0124
 import (
 	"fmt"
 
+	"bitbucket.org/creachadair/stringset"
 	"github.com/googleapis/api-linter/v2/lint"
 	"github.com/googleapis/api-linter/v2/locations"
 	"github.com/googleapis/api-linter/v2/rules/internal/utils"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
-var referenceSamePackage = &lint.FieldRule{
-	Name:   lint.NewRuleName(124, "reference-same-package"),
-	OnlyIf: isUnknownType,
-	LintField: func(f protoreflect.FieldDescriptor) []lint.Problem {
-		// Get the type we are checking for.
-		ref := utils.GetResourceReference(f)
-		urt := ref.GetType()
-		if urt == "" {
-			urt = ref.GetChildType()
-		}
+// referenceSamePackageOptions are the options of the reference-same-package
+// rule.
+type referenceSamePackageOptions struct {
+	// Resource types that may be declared in other packages, in addition to
+	// the common ones.
+	AllowedTypes []string `json:"allowed_types"`
+}
 
-		// Iterate over each dependency file and check for a matching resource.
-		for _, file := range getNonPkgDependencies(f.ParentFile(), f.ParentFile().Package()) {
-			// If we find a message with a resource annotation matching our universal
-			// resource type, then it is in the wrong package.
-			for i := 0; i < file.Messages().Len(); i++ {
-				message := file.Messages().Get(i)
-				if res := utils.GetResource(message); res != nil && res.GetType() == urt {
-					return []lint.Problem{{
-						Message:    fmt.Sprintf("Resource type %q should be declared in the same package as it is referenced.", urt),
-						Descriptor: f,
-						Location:   locations.FieldResourceReference(f),
-					}}
+var referenceSamePackage = &lint.OptionsRule[referenceSamePackageOptions]{
+	New: func(opts referenceSamePackageOptions) lint.ProtoRule {
+		common := commonTypes.Union(stringset.New(opts.AllowedTypes...))
+		return &lint.FieldRule{
+			Name: lint.NewRuleName(124, "reference-same-package"),
+			RuleInfo: lint.RuleInfo{
+				Description: "Resource references should refer to resources in the same package.",
+			},
+			OnlyIf: func(f protoreflect.FieldDescriptor) bool {
+				return isUnknownType(f, common)
+			},
+			LintField: func(f protoreflect.FieldDescriptor) []lint.Problem {
+				// Get the type we are checking for.
+				ref := utils.GetResourceReference(f)
+				urt := ref.GetType()
+				if urt == "" {
+					urt = ref.GetChildType()
 				}
-			}
 
-			// Some resources are defined as file annotations. Check for these too.
-			for _, rd := range utils.GetResourceDefinitions(file) {
-				if rd.GetType() == urt {
-					return []lint.Problem{{
-						Message:    fmt.Sprintf("Resource type %q should be declared in the same package as it is referenced.", urt),
-						Descriptor: f,
-						Location:   locations.FieldResourceReference(f),
-					}}
+				// Iterate over each dependency file and check for a matching resource.
+				for _, file := range getNonPkgDependencies(f.ParentFile(), f.ParentFile().Package()) {
+					// If we find a message with a resource annotation matching our universal
+					// resource type, then it is in the wrong package.
+					for i := 0; i < file.Messages().Len(); i++ {
+						message := file.Messages().Get(i)
+						if res := utils.GetResource(message); res != nil && res.GetType() == urt {
+							return []lint.Problem{{
+								Message:    fmt.Sprintf("Resource type %q should be declared in the same package as it is referenced.", urt),
+								Descriptor: f,
+								Location:   locations.FieldResourceReference(f),
+							}}
+						}
+					}
+
+					// Some resources are defined as file annotations. Check for these too.
+					for _, rd := range utils.GetResourceDefinitions(file) {
+						if rd.GetType() == urt {
+							return []lint.Problem{{
+								Message:    fmt.Sprintf("Resource type %q should be declared in the same package as it is referenced.", urt),
+								Descriptor: f,
+								Location:   locations.FieldResourceReference(f),
+							}}
+						}
+					}
 				}
-			}
-		}
 
-		return nil
+				return nil
+			},
+		}
 	},
 }
 
@@ -28,6 +28,31 @@ import (
 
 var unspecified = &lint.EnumRule{
 	Name: lint.NewRuleName(126, "unspecified"),
+	RuleInfo: lint.RuleInfo{
+		Description: "All enums must have a default unspecified value.",
+		Fixable:     true,
+		Examples: []lint.RuleExample{
+			{
+				Incorrect: `enum Format {
+  HARDCOVER = 0;  // Should have "FORMAT_UNSPECIFIED" first.
+}`,
+				Correct: `enum Format {
+  FORMAT_UNSPECIFIED = 0;
+  HARDCOVER = 1;
+}`,
+			},
+			{
+				Incorrect: `enum Format {
+  UNSPECIFIED = 0;  // Should be "FORMAT_UNSPECIFIED".
+  HARDCOVER = 1;
+}`,
+				Correct: `enum Format {
+  FORMAT_UNSPECIFIED = 0;
+  HARDCOVER = 1;
+}`,
+			},
+		},
+	},
 	LintEnum: func(e protoreflect.EnumDescriptor) []lint.Problem {
 		name := endNum.ReplaceAllString(string(e.Name()), "${1}_${2}")
 		sn := strings.ToUpper(strcase.SnakeCase(name))
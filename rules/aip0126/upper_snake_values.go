@@ -13,6 +13,22 @@ import (
 // All enum values must use UPPER_SNAKE_CASE.
 var enumValueUpperSnakeCase = &lint.EnumRule{
 	Name: lint.NewRuleName(126, "upper-snake-values"),
+	RuleInfo: lint.RuleInfo{
+		Description: "All enum values must be in upper snake case.",
+		Fixable:     true,
+		Examples: []lint.RuleExample{
+			{
+				Incorrect: `enum Format {
+  FORMAT_UNSPECIFIED = 0;
+  hardcover = 1;  // Should be "HARDCOVER".
+}`,
+				Correct: `enum Format {
+  FORMAT_UNSPECIFIED = 0;
+  HARDCOVER = 1;
+}`,
+			},
+		},
+	},
 	LintEnum: func(e protoreflect.EnumDescriptor) []lint.Problem {
 		var problems []lint.Problem
 		for i := 0; i < e.Values().Len(); i++ {
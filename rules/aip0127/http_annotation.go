@@ -23,6 +23,9 @@ import (
 
 var hasAnnotation = &lint.MethodRule{
 	Name: lint.NewRuleName(127, "http-annotation"),
+	RuleInfo: lint.RuleInfo{
+		Description: "HTTP annotations must be present on non-streaming methods.",
+	},
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		hasHTTPRule := len(utils.GetHTTPRules(m)) > 0
 		if hasHTTPRule && m.IsStreamingClient() && m.IsStreamingServer() {
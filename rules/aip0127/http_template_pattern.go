@@ -120,6 +120,9 @@ func checkHTTPPatternMatchesResource(m protoreflect.MethodDescriptor, resourceRe
 
 var httpTemplatePattern = &lint.MethodRule{
 	Name: lint.NewRuleName(127, "http-template-pattern"),
+	RuleInfo: lint.RuleInfo{
+		Description: "HTTP template variable patterns should match the patterns defined by their resources.",
+	},
 	OnlyIf: func(m protoreflect.MethodDescriptor) bool {
 		return len(methodResourceReferences(m)) > 0
 	},
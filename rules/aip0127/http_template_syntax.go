@@ -41,7 +41,10 @@ var (
 // HTTP URL pattern should follow the syntax rules described here:
 // https://github.com/googleapis/googleapis/blob/16db2fb7fab4668bdfa09966513e03581d8f5e35/google/api/http.proto#L224.
 var httpTemplateSyntax = &lint.MethodRule{
-	Name:   lint.NewRuleName(127, "http-template-syntax"),
+	Name: lint.NewRuleName(127, "http-template-syntax"),
+	RuleInfo: lint.RuleInfo{
+		Description: "HTTP patterns should follow the HTTP path template syntax.",
+	},
 	OnlyIf: utils.HasHTTPRules,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		problems := []lint.Problem{}
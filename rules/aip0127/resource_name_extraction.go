@@ -23,6 +23,9 @@ import (
 
 var resourceNameExtraction = &lint.MethodRule{
 	Name: lint.NewRuleName(127, "resource-name-extraction"),
+	RuleInfo: lint.RuleInfo{
+		Description: "HTTP annotations should extract full resource names into variables.",
+	},
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		for _, rule := range utils.GetHTTPRules(m) {
 			for k, v := range rule.GetVariables() {
@@ -25,6 +25,9 @@ import (
 
 var leadingSlash = &lint.MethodRule{
 	Name: lint.NewRuleName(127, "uri-leading-slash"),
+	RuleInfo: lint.RuleInfo{
+		Description: "URIs should always begin with a leading slash.",
+	},
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		for _, http := range utils.GetHTTPRules(m) {
 			if !strings.HasPrefix(http.GetPlainURI(), "/") {
@@ -24,7 +24,11 @@ import (
 )
 
 var resourceAnnotationsField = &lint.MessageRule{
-	Name:   lint.NewRuleName(128, "resource-annotations-field"),
+	Name: lint.NewRuleName(128, "resource-annotations-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Declarative-friendly resources must have an `annotations` field.",
+		Fixable:     true,
+	},
 	OnlyIf: isDeclarativeFriendlyResource,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		f := m.Fields().ByName("annotations")
@@ -22,6 +22,9 @@ import (
 
 var resourceReconcilingBehavior = &lint.FieldRule{
 	Name: lint.NewRuleName(128, "resource-reconciling-behavior"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Declarative-friendly resources should annotate the `reconciling` field as `OUTPUT_ONLY`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return isDeclarativeFriendlyResource(m) && f.Name() == "reconciling"
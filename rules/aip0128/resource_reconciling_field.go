@@ -23,7 +23,11 @@ import (
 )
 
 var resourceReconcilingField = &lint.MessageRule{
-	Name:   lint.NewRuleName(128, "resource-reconciling-field"),
+	Name: lint.NewRuleName(128, "resource-reconciling-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Declarative-friendly resources must have a `reconciling` field.",
+		Fixable:     true,
+	},
 	OnlyIf: isDeclarativeFriendlyResource,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		f := m.Fields().ByName("reconciling")
@@ -21,7 +21,10 @@ import (
 
 // Get methods should not have an HTTP body.
 var httpBody = &lint.MethodRule{
-	Name:       lint.NewRuleName(131, "http-body"),
+	Name: lint.NewRuleName(131, "http-body"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Get methods must not have an HTTP body.",
+	},
 	OnlyIf:     utils.IsGetMethod,
 	LintMethod: utils.LintNoHTTPBody,
 }
@@ -21,7 +21,24 @@ import (
 
 // Get methods should use the HTTP GET verb.
 var httpMethod = &lint.MethodRule{
-	Name:       lint.NewRuleName(131, "http-method"),
+	Name: lint.NewRuleName(131, "http-method"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Get methods must use the GET HTTP verb.",
+		Examples: []lint.RuleExample{
+			{
+				Incorrect: `rpc GetBook(GetBookRequest) returns (Book) {
+  option (google.api.http) = {
+    post: "/v1/{name=publishers/*/books/*}"  // Should be ` + "`get:`" + `.
+  };
+}`,
+				Correct: `rpc GetBook(GetBookRequest) returns (Book) {
+  option (google.api.http) = {
+    get: "/v1/{name=publishers/*/books/*}"
+  };
+}`,
+			},
+		},
+	},
 	OnlyIf:     utils.IsGetMethod,
 	LintMethod: utils.LintHTTPMethod("GET"),
 }
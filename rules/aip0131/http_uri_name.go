@@ -21,7 +21,10 @@ import (
 
 // Get methods should have a proper HTTP pattern.
 var httpNameField = &lint.MethodRule{
-	Name:       lint.NewRuleName(131, "http-uri-name"),
+	Name: lint.NewRuleName(131, "http-uri-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Get methods must map the name field to the URI.",
+	},
 	OnlyIf:     utils.IsGetMethod,
 	LintMethod: utils.LintHTTPURIHasNameVariable,
 }
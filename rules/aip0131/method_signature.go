@@ -25,7 +25,11 @@ import (
 )
 
 var methodSignature = &lint.MethodRule{
-	Name:   lint.NewRuleName(131, "method-signature"),
+	Name: lint.NewRuleName(131, "method-signature"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Get RPCs should annotate a method signature of \"name\".",
+		Fixable:     true,
+	},
 	OnlyIf: utils.IsGetMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		signatures := utils.GetMethodSignatures(m)
@@ -21,7 +21,11 @@ import (
 
 // Get messages should have a properly named Request message.
 var requestMessageName = &lint.MethodRule{
-	Name:       lint.NewRuleName(131, "request-message-name"),
+	Name: lint.NewRuleName(131, "request-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Get methods must have standardized request message names.",
+		Fixable:     true,
+	},
 	OnlyIf:     utils.IsGetMethod,
 	LintMethod: utils.LintMethodHasMatchingRequestName,
 }
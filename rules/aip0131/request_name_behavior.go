@@ -22,6 +22,9 @@ import (
 
 var requestNameBehavior = &lint.FieldRule{
 	Name: lint.NewRuleName(131, "request-name-behavior"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Get RPCs should annotate the `name` field with `google.api.field_behavior`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return utils.IsGetRequestMessage(m) && f.Name() == "name"
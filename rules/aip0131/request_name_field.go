@@ -23,6 +23,10 @@ import (
 // Get request should have a string name field.
 var requestNameField = &lint.FieldRule{
 	Name: lint.NewRuleName(131, "request-name-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Get RPCs must have a `string name` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return utils.IsGetRequestMessage(m) && f.Name() == "name"
@@ -24,6 +24,9 @@ import (
 
 var requestNameReferenceType = &lint.FieldRule{
 	Name: lint.NewRuleName(131, "request-name-reference-type"),
+	RuleInfo: lint.RuleInfo{
+		Description: "The `google.api.resource_reference` on the `name` field of a Get RPC request message should use `type`, not `child_type`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return utils.IsGetRequestMessage(m) && f.Name() == "name" && utils.GetResourceReference(f) != nil
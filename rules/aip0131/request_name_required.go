@@ -24,7 +24,10 @@ import (
 
 // The Get standard method should have some required fields.
 var requestNameRequired = &lint.MessageRule{
-	Name:   lint.NewRuleName(131, "request-name-required"),
+	Name: lint.NewRuleName(131, "request-name-required"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Get RPCs must have a `name` field in the request.",
+	},
 	OnlyIf: utils.IsGetRequestMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		if m.Fields().ByName("name") == nil {
@@ -25,7 +25,10 @@ import (
 
 // The get request message should not have unrecognized fields.
 var requestRequiredFields = &lint.MessageRule{
-	Name:   lint.NewRuleName(131, "request-required-fields"),
+	Name: lint.NewRuleName(131, "request-required-fields"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Get RPCs must not have unexpected required fields in the request.",
+	},
 	OnlyIf: utils.IsGetRequestMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) (problems []lint.Problem) {
 		// Rule check: Establish that there are no unexpected fields.
@@ -34,6 +34,9 @@ var allowedFields = stringset.New(
 // Get methods should not have unrecognized fields.
 var unknownFields = &lint.FieldRule{
 	Name: lint.NewRuleName(131, "request-unknown-fields"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Get RPCs should not have unexpected fields in the request.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return utils.IsGetRequestMessage(m)
@@ -25,7 +25,11 @@ import (
 
 // Get messages should use the resource as the response message
 var responseMessageName = &lint.MethodRule{
-	Name:   lint.NewRuleName(131, "response-message-name"),
+	Name: lint.NewRuleName(131, "response-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Get methods must return the resource.",
+		Fixable:     true,
+	},
 	OnlyIf: utils.IsGetMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		// Rule check: Establish that for methods such as `GetFoo`, the response
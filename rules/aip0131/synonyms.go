@@ -26,6 +26,10 @@ import (
 // Get methods should not generally use synonyms for "get".
 var synonyms = &lint.MethodRule{
 	Name: lint.NewRuleName(131, "synonyms"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Get methods must be named starting with \"Get\".",
+		Fixable:     true,
+	},
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		name := string(m.Name())
 		for _, syn := range []string{"Acquire", "Fetch", "Lookup", "Read", "Retrieve"} {
@@ -21,7 +21,10 @@ import (
 
 // List methods should not have an HTTP body.
 var httpBody = &lint.MethodRule{
-	Name:       lint.NewRuleName(132, "http-body"),
+	Name: lint.NewRuleName(132, "http-body"),
+	RuleInfo: lint.RuleInfo{
+		Description: "List methods must not have an HTTP body.",
+	},
 	OnlyIf:     utils.IsListMethod,
 	LintMethod: utils.LintNoHTTPBody,
 }
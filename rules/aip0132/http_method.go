@@ -21,7 +21,10 @@ import (
 
 // List methods should use the HTTP GET verb.
 var httpMethod = &lint.MethodRule{
-	Name:       lint.NewRuleName(132, "http-method"),
+	Name: lint.NewRuleName(132, "http-method"),
+	RuleInfo: lint.RuleInfo{
+		Description: "List methods must use the GET HTTP verb.",
+	},
 	OnlyIf:     utils.IsListMethod,
 	LintMethod: utils.LintHTTPMethod("GET"),
 }
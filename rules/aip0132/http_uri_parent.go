@@ -23,6 +23,9 @@ import (
 // List methods should have a parent variable if the request has a parent field.
 var httpURIParent = &lint.MethodRule{
 	Name: lint.NewRuleName(132, "http-uri-parent"),
+	RuleInfo: lint.RuleInfo{
+		Description: "List methods must map the parent field to the URI.",
+	},
 	OnlyIf: func(m protoreflect.MethodDescriptor) bool {
 		return utils.IsListMethod(m) && m.Input().Fields().ByName("parent") != nil
 	},
@@ -26,6 +26,10 @@ import (
 
 var methodSignature = &lint.MethodRule{
 	Name: lint.NewRuleName(132, "method-signature"),
+	RuleInfo: lint.RuleInfo{
+		Description: "List RPCs should annotate a method signature of \"parent\".",
+		Fixable:     true,
+	},
 	OnlyIf: func(m protoreflect.MethodDescriptor) bool {
 		return utils.IsListMethod(m) && m.Input().Fields().ByName("parent") != nil
 	},
@@ -29,6 +29,10 @@ var knownFields = map[string]func(protoreflect.FieldDescriptor) []lint.Problem{
 // List fields should have the correct type.
 var requestFieldTypes = &lint.FieldRule{
 	Name: lint.NewRuleName(132, "request-field-types"),
+	RuleInfo: lint.RuleInfo{
+		Description: "List RPCs should have fields with consistent types.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return utils.IsListRequestMessage(m) && knownFields[string(f.Name())] != nil
@@ -21,7 +21,11 @@ import (
 
 // List messages should have a properly named Request message.
 var requestMessageName = &lint.MethodRule{
-	Name:       lint.NewRuleName(132, "request-message-name"),
+	Name: lint.NewRuleName(132, "request-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "List methods must have standardized request message names.",
+		Fixable:     true,
+	},
 	OnlyIf:     utils.IsListMethod,
 	LintMethod: utils.LintMethodHasMatchingRequestName,
 }
@@ -24,6 +24,10 @@ import (
 // be string.
 var requestParentField = &lint.FieldRule{
 	Name: lint.NewRuleName(132, "request-parent-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "List RPCs must have a `parent` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return utils.IsListRequestMessage(m) && f.Name() == "parent"
@@ -12,7 +12,10 @@ import (
 
 // The List standard method should contain a parent field.
 var requestParentRequired = &lint.MessageRule{
-	Name:   lint.NewRuleName(132, "request-parent-required"),
+	Name: lint.NewRuleName(132, "request-parent-required"),
+	RuleInfo: lint.RuleInfo{
+		Description: "List RPCs must have a `parent` field in the request.",
+	},
 	OnlyIf: utils.IsListRequestMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		// Rule check: Establish that a `parent` field is present.
@@ -26,6 +26,9 @@ import (
 
 var requestParentValidReference = &lint.FieldRule{
 	Name: lint.NewRuleName(132, "request-parent-valid-reference"),
+	RuleInfo: lint.RuleInfo{
+		Description: "List RPCs should reference the parent resource, not the listed resource.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		ref := utils.GetResourceReference(f)
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
@@ -25,7 +25,10 @@ import (
 
 // The list request message should not have unrecognized fields.
 var requestRequiredFields = &lint.MessageRule{
-	Name:   lint.NewRuleName(132, "request-required-fields"),
+	Name: lint.NewRuleName(132, "request-required-fields"),
+	RuleInfo: lint.RuleInfo{
+		Description: "List RPCs must not have unexpected required fields in the request.",
+	},
 	OnlyIf: utils.IsListRequestMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) (problems []lint.Problem) {
 		// Rule check: Establish that there are no unexpected fields.
@@ -14,6 +14,9 @@ import (
 // soft delete.
 var requestShowDeletedRequired = &lint.MessageRule{
 	Name: lint.NewRuleName(132, "request-show-deleted-required"),
+	RuleInfo: lint.RuleInfo{
+		Description: "List requests must have a `show-deleted` field for resources supporting soft delete.",
+	},
 	OnlyIf: func(m protoreflect.MessageDescriptor) bool {
 		if !utils.IsListRequestMessage(m) {
 			return false
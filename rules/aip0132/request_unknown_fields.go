@@ -38,6 +38,9 @@ var allowedFields = stringset.New(
 // List methods should not have unrecognized fields.
 var unknownFields = &lint.FieldRule{
 	Name: lint.NewRuleName(132, "request-unknown-fields"),
+	RuleInfo: lint.RuleInfo{
+		Description: "List RPCs should not have unexpected fields in the request.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return utils.IsListRequestMessage(m)
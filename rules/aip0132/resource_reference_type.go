@@ -26,6 +26,9 @@ import (
 // parent directly via `type`.
 var resourceReferenceType = &lint.MethodRule{
 	Name: lint.NewRuleName(132, "resource-reference-type"),
+	RuleInfo: lint.RuleInfo{
+		Description: "List should use a `child_type` reference to the paginated resource.",
+	},
 	OnlyIf: func(m protoreflect.MethodDescriptor) bool {
 		p := m.Input().Fields().ByName("parent")
 
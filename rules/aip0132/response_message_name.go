@@ -21,7 +21,11 @@ import (
 
 // List messages should use a `ListFoosResponse` response message.
 var responseMessageName = &lint.MethodRule{
-	Name:       lint.NewRuleName(132, "response-message-name"),
+	Name: lint.NewRuleName(132, "response-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "List methods must have standardized response message names.",
+		Fixable:     true,
+	},
 	OnlyIf:     utils.IsListMethod,
 	LintMethod: utils.LintMethodHasMatchingResponseName,
 }
@@ -34,6 +34,9 @@ var respAllowedFields = stringset.New(
 
 var responseUnknownFields = &lint.FieldRule{
 	Name: lint.NewRuleName(132, "response-unknown-fields"),
+	RuleInfo: lint.RuleInfo{
+		Description: "List RPCs should not have unexpected fields in the response.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return utils.IsListResponseMessage(m)
@@ -26,7 +26,10 @@ import (
 
 // Create methods should have an HTTP body, and the body value should be resource.
 var httpBody = &lint.MethodRule{
-	Name:   lint.NewRuleName(133, "http-body"),
+	Name: lint.NewRuleName(133, "http-body"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Create methods must have the HTTP body set to the resource.",
+	},
 	OnlyIf: utils.IsCreateMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		resourceMsgName := utils.GetResourceMessageName(m, "Create")
@@ -21,7 +21,10 @@ import (
 
 // Create methods should use the HTTP POST verb.
 var httpMethod = &lint.MethodRule{
-	Name:       lint.NewRuleName(133, "http-method"),
+	Name: lint.NewRuleName(133, "http-method"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Create methods must use the POST HTTP verb.",
+	},
 	OnlyIf:     utils.IsCreateMethod,
 	LintMethod: utils.LintHTTPMethod("POST"),
 }
@@ -24,6 +24,9 @@ import (
 // This should be the only variable in the URI path.
 var httpURIParent = &lint.MethodRule{
 	Name: lint.NewRuleName(133, "http-uri-parent"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Create methods must map the parent field to the URI.",
+	},
 	OnlyIf: func(m protoreflect.MethodDescriptor) bool {
 		// The response type of a Standard Create method must be the resource
 		// itself, unless it is an LRO, in which case, the operation_info field
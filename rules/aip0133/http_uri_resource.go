@@ -27,6 +27,9 @@ import (
 // in the resource definition.
 var httpURIResource = &lint.MethodRule{
 	Name: lint.NewRuleName(133, "http-uri-resource"),
+	RuleInfo: lint.RuleInfo{
+		Description: "The collection where the resource is added should map to the URI path.",
+	},
 	OnlyIf: func(m protoreflect.MethodDescriptor) bool {
 		return utils.IsCreateMethod(m) && len(utils.GetHTTPRules(m)) > 0
 	},
@@ -28,6 +28,10 @@ import (
 
 var methodSignature = &lint.MethodRule{
 	Name: lint.NewRuleName(133, "method-signature"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Create RPCs should annotate an appropriate method signature.",
+		Fixable:     true,
+	},
 	OnlyIf: func(m protoreflect.MethodDescriptor) bool {
 		return utils.IsCreateMethod(m) && utils.IsResource(utils.GetResponseType(m))
 	},
@@ -25,7 +25,10 @@ import (
 )
 
 var requestIDField = &lint.MessageRule{
-	Name:   lint.NewRuleName(133, "request-id-field"),
+	Name: lint.NewRuleName(133, "request-id-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "create methods should have a client-specified ID field.",
+	},
 	OnlyIf: utils.IsCreateRequestMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		idField := strcase.SnakeCase(strings.TrimPrefix(strings.TrimSuffix(string(m.Name()), "Request"), "Create")) + "_id"
@@ -21,7 +21,11 @@ import (
 
 // Create method should have a properly named input message.
 var inputName = &lint.MethodRule{
-	Name:       lint.NewRuleName(133, "request-message-name"),
+	Name: lint.NewRuleName(133, "request-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Create methods must have standardized request message names.",
+		Fixable:     true,
+	},
 	OnlyIf:     utils.IsCreateMethod,
 	LintMethod: utils.LintMethodHasMatchingRequestName,
 }
@@ -22,6 +22,9 @@ import (
 
 var requestParentBehavior = &lint.FieldRule{
 	Name: lint.NewRuleName(133, "request-parent-behavior"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Create RPCs should annotate the `parent` field with `google.api.field_behavior`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return utils.IsCreateRequestMessage(m) && f.Name() == "parent"
@@ -23,6 +23,10 @@ import (
 // The type of the parent field in a create request should be string.
 var requestParentField = &lint.FieldRule{
 	Name: lint.NewRuleName(133, "request-parent-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Create RPCs must have a `parent` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return utils.IsCreateRequestMessage(m) && f.Name() == "parent"
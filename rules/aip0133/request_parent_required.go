@@ -10,7 +10,10 @@ import (
 )
 
 var requestParentRequired = &lint.MessageRule{
-	Name:   lint.NewRuleName(133, "request-parent-required"),
+	Name: lint.NewRuleName(133, "request-parent-required"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Create RPCs must have a `parent` field in the request.",
+	},
 	OnlyIf: utils.IsCreateRequestMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		if m.Fields().ByName("parent") == nil {
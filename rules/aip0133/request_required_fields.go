@@ -27,7 +27,10 @@ import (
 
 // The create request message should not have unrecognized fields.
 var requestRequiredFields = &lint.MethodRule{
-	Name:   lint.NewRuleName(133, "request-required-fields"),
+	Name: lint.NewRuleName(133, "request-required-fields"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Create RPCs must not have unexpected required fields in the request.",
+	},
 	OnlyIf: utils.IsCreateMethodWithResolvedReturnType,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		ot := utils.GetResponseType(m)
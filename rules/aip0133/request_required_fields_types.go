@@ -25,7 +25,10 @@ import (
 
 // The create request message should have standardized field types for required fields.
 var requestRequiredFieldsTypes = &lint.MethodRule{
-	Name:   lint.NewRuleName(133, "request-required-fields-types"),
+	Name: lint.NewRuleName(133, "request-required-fields-types"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Create RPCs must have correct types for required fields.",
+	},
 	OnlyIf: utils.IsCreateMethodWithResolvedReturnType,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		ot := utils.GetResponseType(m)
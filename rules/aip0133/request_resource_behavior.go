@@ -22,6 +22,9 @@ import (
 
 var requestResourceBehavior = &lint.FieldRule{
 	Name: lint.NewRuleName(133, "request-resource-behavior"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Create RPCs should annotate the resource field with `google.api.field_behavior`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if message, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			if !utils.IsCreateRequestMessage(message) {
@@ -26,7 +26,11 @@ import (
 
 // The create request message should have resource field.
 var resourceField = &lint.MessageRule{
-	Name:   lint.NewRuleName(133, "request-resource-field"),
+	Name: lint.NewRuleName(133, "request-resource-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Create RPCs must have a field for the resource in the request.",
+		Fixable:     true,
+	},
 	OnlyIf: utils.IsCreateRequestMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		resourceMsgName := getResourceMsgNameFromReq(m)
@@ -27,7 +27,10 @@ import (
 
 // The create request message should not have unrecognized fields.
 var unknownFields = &lint.MessageRule{
-	Name:   lint.NewRuleName(133, "request-unknown-fields"),
+	Name: lint.NewRuleName(133, "request-unknown-fields"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Create RPCs should not have unexpected fields in the request.",
+	},
 	OnlyIf: utils.IsCreateRequestMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) (problems []lint.Problem) {
 		resourceMsgName := getResourceMsgNameFromReq(m)
@@ -25,6 +25,9 @@ import (
 // parent directly via `type`.
 var resourceReferenceType = &lint.MethodRule{
 	Name: lint.NewRuleName(133, "resource-reference-type"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Create should use a `child_type` reference to the created resource.",
+	},
 	OnlyIf: func(m protoreflect.MethodDescriptor) bool {
 		ot := utils.GetResponseType(m)
 		// Unresolvable response_type for an Operation results in nil here.
@@ -23,6 +23,10 @@ import (
 
 var responseLRO = &lint.MethodRule{
 	Name: lint.NewRuleName(133, "response-lro"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Declarative-friendly create methods should use long-running operations.",
+		Fixable:     true,
+	},
 	OnlyIf: func(m protoreflect.MethodDescriptor) bool {
 		return utils.IsCreateMethod(m) && utils.IsDeclarativeFriendlyMethod(m)
 	},
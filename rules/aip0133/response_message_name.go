@@ -25,7 +25,11 @@ import (
 
 // Create method should use the resource as the output message
 var outputName = &lint.MethodRule{
-	Name:   lint.NewRuleName(133, "response-message-name"),
+	Name: lint.NewRuleName(133, "response-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Create methods must return the resource.",
+		Fixable:     true,
+	},
 	OnlyIf: utils.IsCreateMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		want := utils.GetResourceMessageName(m, "Create")
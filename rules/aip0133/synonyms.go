@@ -26,6 +26,10 @@ import (
 // Create methods should use "create", not synonyms.
 var synonyms = &lint.MethodRule{
 	Name: lint.NewRuleName(133, "synonyms"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Create methods must be named starting with \"Create\".",
+		Fixable:     true,
+	},
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		name := string(m.Name())
 		for _, syn := range []string{"Insert", "Make", "Post"} {
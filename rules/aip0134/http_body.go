@@ -26,7 +26,10 @@ import (
 
 // Update methods should have an HTTP body.
 var httpBody = &lint.MethodRule{
-	Name:   lint.NewRuleName(134, "http-body"),
+	Name: lint.NewRuleName(134, "http-body"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Update methods must have the HTTP body set to the resource.",
+	},
 	OnlyIf: utils.IsUpdateMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		fieldName := strcase.SnakeCase(string(m.Name()[6:]))
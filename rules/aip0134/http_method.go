@@ -21,7 +21,10 @@ import (
 
 // Update methods should use the HTTP PATCH verb.
 var httpMethod = &lint.MethodRule{
-	Name:       lint.NewRuleName(134, "http-method"),
+	Name: lint.NewRuleName(134, "http-method"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Update methods must use the PATCH HTTP verb.",
+	},
 	OnlyIf:     utils.IsUpdateMethod,
 	LintMethod: utils.LintHTTPMethod("PATCH"),
 }
@@ -25,7 +25,10 @@ import (
 
 // Update methods should have a proper HTTP pattern.
 var httpNameField = &lint.MethodRule{
-	Name:   lint.NewRuleName(134, "http-uri-name"),
+	Name: lint.NewRuleName(134, "http-uri-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Update methods must map the resource's name field to the URI.",
+	},
 	OnlyIf: utils.IsUpdateMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		fieldName := strcase.SnakeCase(string(m.Name()[6:]))
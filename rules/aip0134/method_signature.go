@@ -27,7 +27,11 @@ import (
 )
 
 var methodSignature = &lint.MethodRule{
-	Name:   lint.NewRuleName(134, "method-signature"),
+	Name: lint.NewRuleName(134, "method-signature"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Update RPCs should annotate an appropriate method signature.",
+		Fixable:     true,
+	},
 	OnlyIf: utils.IsUpdateMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		signatures := utils.GetMethodSignatures(m)
@@ -22,6 +22,9 @@ import (
 
 var allowMissing = &lint.MessageRule{
 	Name: lint.NewRuleName(134, "request-allow-missing-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Update RPCs on declarative-friendly resources should include allow_missing.",
+	},
 	OnlyIf: func(m protoreflect.MessageDescriptor) bool {
 		if !utils.IsUpdateRequestMessage(m) {
 			return false
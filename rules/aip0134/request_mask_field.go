@@ -22,6 +22,10 @@ import (
 
 var requestMaskField = &lint.FieldRule{
 	Name: lint.NewRuleName(134, "request-mask-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Update RPCs must have a field mask in the request.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return utils.IsUpdateRequestMessage(m) && f.Name() == "update_mask"
@@ -21,7 +21,10 @@ import (
 )
 
 var requestMaskRequired = &lint.MessageRule{
-	Name:   lint.NewRuleName(134, "request-mask-required"),
+	Name: lint.NewRuleName(134, "request-mask-required"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Update RPCs must have a field mask in the request.",
+	},
 	OnlyIf: utils.IsUpdateRequestMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		updateMask := m.Fields().ByName("update_mask")
@@ -21,7 +21,11 @@ import (
 
 // Update methods should have a properly named Request message.
 var requestMessageName = &lint.MethodRule{
-	Name:       lint.NewRuleName(134, "request-message-name"),
+	Name: lint.NewRuleName(134, "request-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Update methods must have standardized request message names.",
+		Fixable:     true,
+	},
 	OnlyIf:     utils.IsUpdateMethod,
 	LintMethod: utils.LintMethodHasMatchingRequestName,
 }
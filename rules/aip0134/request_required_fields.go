@@ -25,7 +25,10 @@ import (
 
 // The update request message should not have unrecognized fields.
 var requestRequiredFields = &lint.MethodRule{
-	Name:   lint.NewRuleName(134, "request-required-fields"),
+	Name: lint.NewRuleName(134, "request-required-fields"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Update RPCs must not have unexpected required fields in the request.",
+	},
 	OnlyIf: utils.IsUpdateMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) (problems []lint.Problem) {
 		ot := utils.GetResponseType(m)
@@ -27,6 +27,10 @@ import (
 // The resource field in a update method should named properly.
 var requestResourceField = &lint.FieldRule{
 	Name: lint.NewRuleName(134, "request-resource-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Update RPCs must have a field for the resource in the request.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if message, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return utils.IsUpdateRequestMessage(message) &&
@@ -10,7 +10,10 @@ import (
 
 // The create request message should have resource field.
 var requestResourceRequired = &lint.MessageRule{
-	Name:   lint.NewRuleName(134, "request-resource-required"),
+	Name: lint.NewRuleName(134, "request-resource-required"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Update RPCs must have a field for the resource in the request.",
+	},
 	OnlyIf: utils.IsUpdateRequestMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		resourceMsgName := extractResource(string(m.Name()))
@@ -27,7 +27,10 @@ import (
 
 // Update methods should not have unrecognized fields.
 var unknownFields = &lint.MessageRule{
-	Name:   lint.NewRuleName(134, "request-unknown-fields"),
+	Name: lint.NewRuleName(134, "request-unknown-fields"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Update RPCs should not have unexpected fields in the request.",
+	},
 	OnlyIf: utils.IsUpdateRequestMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) (problems []lint.Problem) {
 		resource := extractResource(string(m.Name()))
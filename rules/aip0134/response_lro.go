@@ -23,6 +23,10 @@ import (
 
 var responseLRO = &lint.MethodRule{
 	Name: lint.NewRuleName(134, "response-lro"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Declarative-friendly Update methods should use long-running operations.",
+		Fixable:     true,
+	},
 	OnlyIf: func(m protoreflect.MethodDescriptor) bool {
 		return utils.IsUpdateMethod(m) && utils.IsDeclarativeFriendlyMethod(m)
 	},
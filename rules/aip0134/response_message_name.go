@@ -26,7 +26,11 @@ import (
 
 // Update methods should use the resource as the response message
 var responseMessageName = &lint.MethodRule{
-	Name:   lint.NewRuleName(134, "response-message-name"),
+	Name: lint.NewRuleName(134, "response-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Update methods must return the resource.",
+		Fixable:     true,
+	},
 	OnlyIf: utils.IsUpdateMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		// Rule check: Establish that for methods such as `UpdateFoo`, the response
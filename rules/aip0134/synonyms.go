@@ -27,6 +27,10 @@ import (
 // Update methods should use the word "update", not synonyms.
 var synonyms = &lint.MethodRule{
 	Name: lint.NewRuleName(134, "synonyms"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Update methods must be named starting with \"Update\".",
+		Fixable:     true,
+	},
 	OnlyIf: func(m protoreflect.MethodDescriptor) bool {
 		return m.Name() != "SetIamPolicy"
 	},
@@ -23,6 +23,9 @@ import (
 
 var updateMaskOptionalBehavior = &lint.FieldRule{
 	Name: lint.NewRuleName(134, "update-mask-optional-behavior"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Standard Update `update_mask` field must be `OPTIONAL`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return f.Name() == "update_mask" && utils.IsUpdateRequestMessage(m)
@@ -23,6 +23,9 @@ import (
 // Delete methods for resources that are parents should have a bool force field.
 var forceField = &lint.MessageRule{
 	Name: lint.NewRuleName(135, "force-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Delete RPCs for resources with child collections should have a `force` field in the request.",
+	},
 	OnlyIf: func(m protoreflect.MessageDescriptor) bool {
 		name := m.Fields().ByName("name")
 		ref := utils.GetResourceReference(name)
@@ -21,7 +21,10 @@ import (
 
 // Delete methods should not have an HTTP body.
 var httpBody = &lint.MethodRule{
-	Name:       lint.NewRuleName(135, "http-body"),
+	Name: lint.NewRuleName(135, "http-body"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Delete methods must not have an HTTP body.",
+	},
 	OnlyIf:     utils.IsDeleteMethod,
 	LintMethod: utils.LintNoHTTPBody,
 }
@@ -21,7 +21,10 @@ import (
 
 // Delete methods should use the HTTP DELETE method.
 var httpMethod = &lint.MethodRule{
-	Name:       lint.NewRuleName(135, "http-method"),
+	Name: lint.NewRuleName(135, "http-method"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Delete methods must use the DELETE HTTP verb.",
+	},
 	OnlyIf:     utils.IsDeleteMethod,
 	LintMethod: utils.LintHTTPMethod("DELETE"),
 }
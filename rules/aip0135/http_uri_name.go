@@ -21,7 +21,10 @@ import (
 
 // Delete methods should have a proper HTTP pattern.
 var httpNameField = &lint.MethodRule{
-	Name:       lint.NewRuleName(135, "http-uri-name"),
+	Name: lint.NewRuleName(135, "http-uri-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Delete methods must map the name field to the URI.",
+	},
 	OnlyIf:     utils.IsDeleteMethod,
 	LintMethod: utils.LintHTTPURIHasNameVariable,
 }
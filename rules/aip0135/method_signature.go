@@ -26,7 +26,11 @@ import (
 )
 
 var methodSignature = &lint.MethodRule{
-	Name:   lint.NewRuleName(135, "method-signature"),
+	Name: lint.NewRuleName(135, "method-signature"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Delete RPCs should annotate a method signature of \"name\".",
+		Fixable:     true,
+	},
 	OnlyIf: utils.IsDeleteMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		signatures := utils.GetMethodSignatures(m)
@@ -22,6 +22,10 @@ import (
 
 var requestForceField = &lint.FieldRule{
 	Name: lint.NewRuleName(135, "request-force-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Delete request `force` fields must have type `bool`.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return utils.IsDeleteRequestMessage(m) && f.Name() == "force"
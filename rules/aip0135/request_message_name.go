@@ -21,7 +21,11 @@ import (
 
 // Delete messages should have a properly named Request message.
 var requestMessageName = &lint.MethodRule{
-	Name:       lint.NewRuleName(135, "request-message-name"),
+	Name: lint.NewRuleName(135, "request-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Delete methods must have standardized request message names.",
+		Fixable:     true,
+	},
 	OnlyIf:     utils.IsDeleteMethod,
 	LintMethod: utils.LintMethodHasMatchingRequestName,
 }
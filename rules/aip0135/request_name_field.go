@@ -22,6 +22,10 @@ import (
 
 var requestNameField = &lint.FieldRule{
 	Name: lint.NewRuleName(135, "request-name-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Delete RPCs must have a `name` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return utils.IsDeleteRequestMessage(m) && f.Name() == "name"
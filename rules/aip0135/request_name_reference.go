@@ -22,6 +22,9 @@ import (
 
 var requestNameReference = &lint.FieldRule{
 	Name: lint.NewRuleName(135, "request-name-reference"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Delete RPCs should annotate the `name` field with `google.api.resource_reference`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return utils.IsDeleteRequestMessage(m) && f.Name() == "name"
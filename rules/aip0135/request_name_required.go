@@ -23,7 +23,10 @@ import (
 )
 
 var requestNameRequired = &lint.MessageRule{
-	Name:   lint.NewRuleName(135, "request-name-required"),
+	Name: lint.NewRuleName(135, "request-name-required"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Delete RPCs must have a `name` field in the request.",
+	},
 	OnlyIf: utils.IsDeleteRequestMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		if m.Fields().ByName("name") == nil {
@@ -26,7 +26,10 @@ import (
 
 // Delete methods should not have unrecognized fields.
 var unknownFields = &lint.MessageRule{
-	Name:   lint.NewRuleName(135, "request-unknown-fields"),
+	Name: lint.NewRuleName(135, "request-unknown-fields"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Delete RPCs should not have unexpected fields in the request.",
+	},
 	OnlyIf: utils.IsDeleteRequestMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) (problems []lint.Problem) {
 		// Rule check: Establish that there are no unexpected fields.
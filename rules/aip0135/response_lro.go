@@ -23,6 +23,10 @@ import (
 
 var responseLRO = &lint.MethodRule{
 	Name: lint.NewRuleName(135, "response-lro"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Declarative-friendly delete methods should use long-running operations.",
+		Fixable:     true,
+	},
 	OnlyIf: func(m protoreflect.MethodDescriptor) bool {
 		return utils.IsDeleteMethod(m) && utils.IsDeclarativeFriendlyMethod(m)
 	},
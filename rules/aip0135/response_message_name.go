@@ -29,7 +29,11 @@ import (
 // google.longrunning.Operation, or the resource itself as the response
 // message.
 var responseMessageName = &lint.MethodRule{
-	Name:   lint.NewRuleName(135, "response-message-name"),
+	Name: lint.NewRuleName(135, "response-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Delete methods must return Empty or the resource.",
+		Fixable:     true,
+	},
 	OnlyIf: utils.IsDeleteMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		resource := strings.Replace(string(m.Name()), "Delete", "", 1)
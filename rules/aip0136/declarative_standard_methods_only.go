@@ -24,7 +24,10 @@ import (
 )
 
 var standardMethodsOnly = &lint.MethodRule{
-	Name:   lint.NewRuleName(136, "declarative-standard-methods-only"),
+	Name: lint.NewRuleName(136, "declarative-standard-methods-only"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Declarative-friendly resources should eschew custom methods.",
+	},
 	OnlyIf: utils.IsDeclarativeFriendlyMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		// Standard methods are fine.
@@ -28,7 +28,10 @@ import (
 var allowedBodyTypes = stringset.New("google.api.HttpBody")
 
 var httpBody = &lint.MethodRule{
-	Name:   lint.NewRuleName(136, "http-body"),
+	Name: lint.NewRuleName(136, "http-body"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Custom methods must have the HTTP body set to `*`.",
+	},
 	OnlyIf: utils.IsCustomMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		for _, httpRule := range utils.GetHTTPRules(m) {
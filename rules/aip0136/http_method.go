@@ -24,7 +24,10 @@ import (
 )
 
 var httpMethod = &lint.MethodRule{
-	Name:   lint.NewRuleName(136, "http-method"),
+	Name: lint.NewRuleName(136, "http-method"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Custom methods must use the POST or GET HTTP verb.",
+	},
 	OnlyIf: utils.IsCustomMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		// ExpungeFoo is still a custom method, but delete is expected as a
@@ -26,7 +26,10 @@ import (
 )
 
 var httpNameVariable = &lint.MethodRule{
-	Name:   lint.NewRuleName(136, "http-name-variable"),
+	Name: lint.NewRuleName(136, "http-name-variable"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Custom methods should only use `name` if the RPC noun matches the resource.",
+	},
 	OnlyIf: utils.IsCustomMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		p := pluralize.NewClient()
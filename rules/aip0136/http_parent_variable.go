@@ -26,7 +26,10 @@ import (
 )
 
 var httpParentVariable = &lint.MethodRule{
-	Name:   lint.NewRuleName(136, "http-parent-variable"),
+	Name: lint.NewRuleName(136, "http-parent-variable"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Custom methods should only use `parent` if the RPC noun matches the resource.",
+	},
 	OnlyIf: utils.IsCustomMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		p := pluralize.NewClient()
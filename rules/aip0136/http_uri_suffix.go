@@ -28,6 +28,9 @@ import (
 
 var uriSuffix = &lint.MethodRule{
 	Name: lint.NewRuleName(136, "http-uri-suffix"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Custom methods should have a correct URI suffix.",
+	},
 	OnlyIf: func(m protoreflect.MethodDescriptor) bool {
 		return utils.IsCustomMethod(m) && httpNameVariable.LintMethod(m) == nil && httpParentVariable.LintMethod(m) == nil
 	},
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strings"
 
+	"bitbucket.org/creachadair/stringset"
 	"github.com/googleapis/api-linter/v2/lint"
 	"github.com/googleapis/api-linter/v2/locations"
 	"github.com/googleapis/api-linter/v2/rules/internal/data"
@@ -25,18 +26,34 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
-var noPrepositions = &lint.MethodRule{
-	Name: lint.NewRuleName(136, "prepositions"),
-	LintMethod: func(m protoreflect.MethodDescriptor) (problems []lint.Problem) {
-		for _, word := range strings.Split(strcase.SnakeCase(string(m.Name())), "_") {
-			if data.Prepositions.Contains(word) {
-				problems = append(problems, lint.Problem{
-					Message:    fmt.Sprintf("Method names should not include prepositions (%q).", word),
-					Descriptor: m,
-					Location:   locations.DescriptorName(m),
-				})
-			}
+// prepositionsOptions are the options of the prepositions rule.
+type prepositionsOptions struct {
+	// Words to report in addition to the common prepositions.
+	AdditionalWords []string `json:"additional_words"`
+	// Prepositions not to report.
+	AllowedWords []string `json:"allowed_words"`
+}
+
+var noPrepositions = &lint.OptionsRule[prepositionsOptions]{
+	New: func(opts prepositionsOptions) lint.ProtoRule {
+		prepositions := data.Prepositions.Union(stringset.New(opts.AdditionalWords...)).Diff(stringset.New(opts.AllowedWords...))
+		return &lint.MethodRule{
+			Name: lint.NewRuleName(136, "prepositions"),
+			RuleInfo: lint.RuleInfo{
+				Description: "Custom methods must not include prepositions in their names.",
+			},
+			LintMethod: func(m protoreflect.MethodDescriptor) (problems []lint.Problem) {
+				for _, word := range strings.Split(strcase.SnakeCase(string(m.Name())), "_") {
+					if prepositions.Contains(word) {
+						problems = append(problems, lint.Problem{
+							Message:    fmt.Sprintf("Method names should not include prepositions (%q).", word),
+							Descriptor: m,
+							Location:   locations.DescriptorName(m),
+						})
+					}
+				}
+				return
+			},
 		}
-		return
 	},
 }
@@ -21,7 +21,11 @@ import (
 
 // Custom methods should have a properly named Request message.
 var requestMessageName = &lint.MethodRule{
-	Name:       lint.NewRuleName(136, "request-message-name"),
+	Name: lint.NewRuleName(136, "request-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Custom methods must have standardized request message names.",
+		Fixable:     true,
+	},
 	OnlyIf:     utils.IsCustomMethod,
 	LintMethod: utils.LintMethodHasMatchingRequestName,
 }
@@ -30,7 +30,11 @@ const responseMessageNameErrorMessage = "" +
 // Custom methods should return a response message matching the RPC name,
 // with a Response suffix, or the resource being operated on.
 var responseMessageName = &lint.MethodRule{
-	Name:   lint.NewRuleName(136, "response-message-name"),
+	Name: lint.NewRuleName(136, "response-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Custom methods must have standardized response message names.",
+		Fixable:     true,
+	},
 	OnlyIf: utils.IsCustomMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		// A response is considered valid if
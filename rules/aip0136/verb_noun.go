@@ -25,6 +25,9 @@ import (
 
 var verbNoun = &lint.MethodRule{
 	Name: lint.NewRuleName(136, "verb-noun"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Custom methods should be named with the verb, then the noun.",
+	},
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		// We can not detect this precisely without a full dictionary (probably
 		// not worth it), but we can catch some common mistakes.
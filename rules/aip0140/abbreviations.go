@@ -34,41 +34,67 @@ var expectedAbbreviations = map[string]string{
 	"statistics":    "stats",
 }
 
-var abbreviations = &lint.DescriptorRule{
-	Name: lint.NewRuleName(140, "abbreviations"),
-	LintDescriptor: func(d protoreflect.Descriptor) (problems []lint.Problem) {
-		// Determine the correct case function to use.
-		// Most things in protobuf are PascalCase; the two exceptions are
-		// fields (snake case) and enum values (UPPER_CAMEL_CASE).
-		//
-		// We do not need to worry about word separators though, since
-		// we are checking for single words only.
-		caseFunc := cases.Title(language.AmericanEnglish).String
-		switch d.(type) {
-		case protoreflect.FieldDescriptor:
-			caseFunc = strings.ToLower
-		case protoreflect.EnumValueDescriptor:
-			caseFunc = strings.ToUpper
-		}
+// abbreviationsOptions are the options of the abbreviations rule.
+type abbreviationsOptions struct {
+	// Abbreviations to expect in addition to the common ones, keyed by the
+	// long form.
+	Abbreviations map[string]string `json:"abbreviations"`
+}
 
-		// Iterate over each abbreviation and determine whether the descriptor's
-		// name includes the long name.
+var abbreviations = &lint.OptionsRule[abbreviationsOptions]{
+	New: func(opts abbreviationsOptions) lint.ProtoRule {
+		expected := map[string]string{}
 		for long, short := range expectedAbbreviations {
-			for _, segment := range strings.Split(strcase.SnakeCase(string(d.Name())), "_") {
-				if segment == long {
-					problems = append(problems, lint.Problem{
-						Message: fmt.Sprintf(
-							"Use the common abbreviation %q instead of %q.",
-							caseFunc(short),
-							caseFunc(long),
-						),
-						Suggestion: strings.ReplaceAll(string(d.Name()), caseFunc(long), caseFunc(short)),
-						Descriptor: d,
-						Location:   locations.DescriptorName(d),
-					})
-				}
-			}
+			expected[long] = short
+		}
+		for long, short := range opts.Abbreviations {
+			expected[long] = short
+		}
+		return &lint.DescriptorRule{
+			Name: lint.NewRuleName(140, "abbreviations"),
+			RuleInfo: lint.RuleInfo{
+				Description: "Field names should use common abbreviations.",
+				Fixable:     true,
+			},
+			LintDescriptor: func(d protoreflect.Descriptor) []lint.Problem {
+				return lintAbbreviations(d, expected)
+			},
 		}
-		return
 	},
 }
+
+func lintAbbreviations(d protoreflect.Descriptor, expectedAbbreviations map[string]string) (problems []lint.Problem) {
+	// Determine the correct case function to use.
+	// Most things in protobuf are PascalCase; the two exceptions are
+	// fields (snake case) and enum values (UPPER_CAMEL_CASE).
+	//
+	// We do not need to worry about word separators though, since
+	// we are checking for single words only.
+	caseFunc := cases.Title(language.AmericanEnglish).String
+	switch d.(type) {
+	case protoreflect.FieldDescriptor:
+		caseFunc = strings.ToLower
+	case protoreflect.EnumValueDescriptor:
+		caseFunc = strings.ToUpper
+	}
+
+	// Iterate over each abbreviation and determine whether the descriptor's
+	// name includes the long name.
+	for long, short := range expectedAbbreviations {
+		for _, segment := range strings.Split(strcase.SnakeCase(string(d.Name())), "_") {
+			if segment == long {
+				problems = append(problems, lint.Problem{
+					Message: fmt.Sprintf(
+						"Use the common abbreviation %q instead of %q.",
+						caseFunc(short),
+						caseFunc(long),
+					),
+					Suggestion: strings.ReplaceAll(string(d.Name()), caseFunc(long), caseFunc(short)),
+					Descriptor: d,
+					Location:   locations.DescriptorName(d),
+				})
+			}
+		}
+	}
+	return
+}
@@ -23,7 +23,10 @@ import (
 )
 
 var base64 = &lint.FieldRule{
-	Name:   lint.NewRuleName(140, "base64"),
+	Name: lint.NewRuleName(140, "base64"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Base64 fields should use the `bytes` type.",
+	},
 	OnlyIf: isStringField,
 	LintField: func(f protoreflect.FieldDescriptor) []lint.Problem {
 		comment := strings.ToLower(string(f.ParentFile().SourceLocations().ByDescriptor(f).LeadingComments))
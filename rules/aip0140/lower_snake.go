@@ -25,6 +25,22 @@ import (
 // Field names must be snake case.
 var lowerSnake = &lint.FieldRule{
 	Name: lint.NewRuleName(140, "lower-snake"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Field names should use `snake_case`.",
+		Fixable:     true,
+		Examples: []lint.RuleExample{
+			{
+				Incorrect: `message Book {
+  string name = 1;
+  int32 pageCount = 2;  // Should be ` + "`page_count`" + `.
+}`,
+				Correct: `message Book {
+  string name = 1;
+  int32 page_count = 2;
+}`,
+			},
+		},
+	},
 	LintField: func(f protoreflect.FieldDescriptor) []lint.Problem {
 		if got, want := f.Name(), toLowerSnakeCase(string(f.Name())); string(got) != want {
 			return []lint.Problem{{
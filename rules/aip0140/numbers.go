@@ -25,6 +25,9 @@ import (
 
 var numbers = &lint.FieldRule{
 	Name: lint.NewRuleName(140, "numbers"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Field names should not have words beginning with numbers.",
+	},
 	LintField: func(f protoreflect.FieldDescriptor) []lint.Problem {
 		for _, segment := range strings.Split(string(f.Name()), "_") {
 			if numberStart.MatchString(segment) {
@@ -25,21 +25,41 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
-var noPrepositions = &lint.FieldRule{
-	Name: lint.NewRuleName(140, "prepositions"),
-	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
-		return !stringset.New("order_by", "group_by", "hour_of_day", "day_of_week").Contains(string(f.Name()))
-	},
-	LintField: func(f protoreflect.FieldDescriptor) (problems []lint.Problem) {
-		for _, word := range strings.Split(string(f.Name()), "_") {
-			if data.Prepositions.Contains(word) {
-				problems = append(problems, lint.Problem{
-					Message:    fmt.Sprintf("Avoid using %q in field names.", word),
-					Descriptor: f,
-					Location:   locations.DescriptorName(f),
-				})
-			}
+// prepositionsOptions are the options of the prepositions rule.
+type prepositionsOptions struct {
+	// Words to report in addition to the common prepositions.
+	AdditionalWords []string `json:"additional_words"`
+	// Prepositions not to report.
+	AllowedWords []string `json:"allowed_words"`
+	// Field names not to report, in addition to the common ones such as
+	// "order_by".
+	AllowedNames []string `json:"allowed_names"`
+}
+
+var noPrepositions = &lint.OptionsRule[prepositionsOptions]{
+	New: func(opts prepositionsOptions) lint.ProtoRule {
+		prepositions := data.Prepositions.Union(stringset.New(opts.AdditionalWords...)).Diff(stringset.New(opts.AllowedWords...))
+		allowedNames := stringset.New("order_by", "group_by", "hour_of_day", "day_of_week").Union(stringset.New(opts.AllowedNames...))
+		return &lint.FieldRule{
+			Name: lint.NewRuleName(140, "prepositions"),
+			RuleInfo: lint.RuleInfo{
+				Description: "Fields must not include prepositions in their names.",
+			},
+			OnlyIf: func(f protoreflect.FieldDescriptor) bool {
+				return !allowedNames.Contains(string(f.Name()))
+			},
+			LintField: func(f protoreflect.FieldDescriptor) (problems []lint.Problem) {
+				for _, word := range strings.Split(string(f.Name()), "_") {
+					if prepositions.Contains(word) {
+						problems = append(problems, lint.Problem{
+							Message:    fmt.Sprintf("Avoid using %q in field names.", word),
+							Descriptor: f,
+							Location:   locations.DescriptorName(f),
+						})
+					}
+				}
+				return
+			},
 		}
-		return
 	},
 }
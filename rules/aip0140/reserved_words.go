@@ -23,17 +23,33 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
-var reservedWords = &lint.FieldRule{
-	Name: lint.NewRuleName(140, "reserved-words"),
-	LintField: func(f protoreflect.FieldDescriptor) []lint.Problem {
-		if name := f.Name(); reservedWordsSet.Contains(string(name)) {
-			return []lint.Problem{{
-				Message:    fmt.Sprintf("%q is a reserved word in a common language and should not be used.", name),
-				Descriptor: f,
-				Location:   locations.DescriptorName(f),
-			}}
+// reservedWordsOptions are the options of the reserved-words rule.
+type reservedWordsOptions struct {
+	// Words to report in addition to the reserved words of common languages.
+	AdditionalWords []string `json:"additional_words"`
+	// Words not to report, even if they are reserved.
+	AllowedWords []string `json:"allowed_words"`
+}
+
+var reservedWords = &lint.OptionsRule[reservedWordsOptions]{
+	New: func(opts reservedWordsOptions) lint.ProtoRule {
+		words := reservedWordsSet.Union(stringset.New(opts.AdditionalWords...)).Diff(stringset.New(opts.AllowedWords...))
+		return &lint.FieldRule{
+			Name: lint.NewRuleName(140, "reserved-words"),
+			RuleInfo: lint.RuleInfo{
+				Description: "Field names must not be reserved words.",
+			},
+			LintField: func(f protoreflect.FieldDescriptor) []lint.Problem {
+				if name := f.Name(); words.Contains(string(name)) {
+					return []lint.Problem{{
+						Message:    fmt.Sprintf("%q is a reserved word in a common language and should not be used.", name),
+						Descriptor: f,
+						Location:   locations.DescriptorName(f),
+					}}
+				}
+				return nil
+			},
 		}
-		return nil
 	},
 }
 
@@ -17,6 +17,7 @@ package aip0140
 import (
 	"testing"
 
+	"github.com/googleapis/api-linter/v2/lint"
 	"github.com/googleapis/api-linter/v2/rules/internal/testutils"
 )
 
@@ -37,3 +38,35 @@ func TestReservedWords(t *testing.T) {
 		})
 	})
 }
+
+func TestReservedWordsOptions(t *testing.T) {
+	rule, err := reservedWords.WithOptions(lint.RuleOptions{
+		"additional_words": []interface{}{"shelf"},
+		"allowed_words":    []interface{}{"package"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		name      string
+		FieldName string
+		problems  testutils.Problems
+	}{
+		{"Additional", "shelf", testutils.Problems{{Message: "shelf"}}},
+		{"Allowed", "package", nil},
+		{"Reserved", "import", testutils.Problems{{Message: "import"}}},
+		{"Valid", "title", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				message Book {
+					string {{.FieldName}} = 1;
+				}
+			`, test)
+			field := f.Messages().Get(0).Fields().Get(0)
+			if diff := test.problems.SetDescriptor(field).Diff(rule.Lint(f)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -25,6 +25,10 @@ import (
 
 var underscores = &lint.FieldRule{
 	Name: lint.NewRuleName(140, "underscores"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Field names must not have goofy underscores.",
+		Fixable:     true,
+	},
 	LintField: func(f protoreflect.FieldDescriptor) []lint.Problem {
 		n := string(f.Name())
 		if strings.HasPrefix(n, "_") || strings.HasSuffix(n, "_") || strings.Contains(n, "__") {
@@ -28,6 +28,10 @@ var uriInCommentRegexp = regexp.MustCompile(`\b(uri|URI)\b`)
 
 var uri = &lint.FieldRule{
 	Name: lint.NewRuleName(140, "uri"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Field names should prefer `uri` to `url`.",
+		Fixable:     true,
+	},
 	LintField: func(f protoreflect.FieldDescriptor) []lint.Problem {
 		nameSegments := stringset.New(strings.Split(string(f.Name()), "_")...)
 		if !nameSegments.Contains("url") {
@@ -25,6 +25,10 @@ import (
 
 var count = &lint.FieldRule{
 	Name: lint.NewRuleName(141, "count-suffix"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Quantities should use a `_count` suffix.",
+		Fixable:     true,
+	},
 	LintField: func(f protoreflect.FieldDescriptor) []lint.Problem {
 		if n := string(f.Name()); strings.HasPrefix(n, "num_") {
 			want := pluralize.NewClient().Singular(n[4:]) + "_count"
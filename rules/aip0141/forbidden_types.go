@@ -26,6 +26,10 @@ import (
 
 var forbiddenTypes = &lint.FieldRule{
 	Name: lint.NewRuleName(141, "forbidden-types"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Fields should avoid unsigned integer types.",
+		Fixable:     true,
+	},
 	LintField: func(f protoreflect.FieldDescriptor) []lint.Problem {
 		nope := stringset.New("fixed32", "fixed64", "uint32", "uint64")
 		if typeName := utils.GetTypeName(f); nope.Contains(typeName) {
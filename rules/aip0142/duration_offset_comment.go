@@ -24,6 +24,9 @@ import (
 
 var durationOffsetComment = &lint.FieldRule{
 	Name: lint.NewRuleName(142, "duration-offset-comment"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Duration fields ending in `_offset` must have a clarifying comment.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		return utils.GetTypeName(f) == "google.protobuf.Duration" && strings.HasSuffix(string(f.Name()), "_offset")
 	},
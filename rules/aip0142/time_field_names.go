@@ -23,7 +23,11 @@ import (
 )
 
 var fieldNames = &lint.FieldRule{
-	Name:   lint.NewRuleName(142, "time-field-names"),
+	Name: lint.NewRuleName(142, "time-field-names"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Timestamps should use `google.protobuf.Timestamp`.",
+		Fixable:     true,
+	},
 	OnlyIf: isTimestamp,
 	LintField: func(f protoreflect.FieldDescriptor) []lint.Problem {
 		// Look for common non-imperative terms.
@@ -26,6 +26,10 @@ import (
 
 var fieldType = &lint.FieldRule{
 	Name: lint.NewRuleName(142, "time-field-type"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Timestamps should use `google.protobuf.Timestamp`.",
+		Fixable:     true,
+	},
 	LintField: func(f protoreflect.FieldDescriptor) []lint.Problem {
 		tokens := strings.Split(string(f.Name()), "_")
 		suffix := tokens[len(tokens)-1]
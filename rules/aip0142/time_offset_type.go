@@ -25,6 +25,10 @@ import (
 
 var timeOffsetType = &lint.FieldRule{
 	Name: lint.NewRuleName(142, "time-offset-type"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Fields ending in `_time_offset` must be of type `google.protobuf.Duration`.",
+		Fixable:     true,
+	},
 	LintField: func(f protoreflect.FieldDescriptor) []lint.Problem {
 		if utils.GetTypeName(f) != "google.protobuf.Duration" && strings.HasSuffix(string(f.Name()), "_time_offset") {
 			return []lint.Problem{{
@@ -25,6 +25,10 @@ import (
 
 var fieldNames = &lint.FieldRule{
 	Name: lint.NewRuleName(143, "standardized-codes"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Fields representing concepts with standardized codes must use them.",
+		Fixable:     true,
+	},
 	OnlyIf: func(fd protoreflect.FieldDescriptor) bool {
 		return !utils.HasResourceReference(fd)
 	},
@@ -26,6 +26,10 @@ import (
 
 var fieldTypes = &lint.FieldRule{
 	Name: lint.NewRuleName(143, "string-type"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Fields representing standardized codes must be strings.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		return stringset.New(
 			"country_code",
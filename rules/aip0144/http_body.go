@@ -21,7 +21,10 @@ import (
 
 // Add/Remove methods should use "*" as the HTTP body.
 var httpBody = &lint.MethodRule{
-	Name:       lint.NewRuleName(144, "http-body"),
+	Name: lint.NewRuleName(144, "http-body"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Add/Remove methods should use `*` as the HTTP body.",
+	},
 	OnlyIf:     isAddRemoveMethod,
 	LintMethod: utils.LintWildcardHTTPBody,
 }
@@ -21,7 +21,10 @@ import (
 
 // Add/Remove methods should use the HTTP POST method.
 var httpMethod = &lint.MethodRule{
-	Name:       lint.NewRuleName(144, "http-method"),
+	Name: lint.NewRuleName(144, "http-method"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Add/Remove methods must use the POST HTTP verb.",
+	},
 	OnlyIf:     isAddRemoveMethod,
 	LintMethod: utils.LintHTTPMethod("POST"),
 }
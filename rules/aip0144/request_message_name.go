@@ -21,7 +21,11 @@ import (
 
 // Add/Remove methods should have a properly named request message.
 var requestMessageName = &lint.MethodRule{
-	Name:       lint.NewRuleName(144, "request-message-name"),
+	Name: lint.NewRuleName(144, "request-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Add/Remove methods must have standardized request message names.",
+		Fixable:     true,
+	},
 	OnlyIf:     isAddRemoveMethod,
 	LintMethod: utils.LintMethodHasMatchingRequestName,
 }
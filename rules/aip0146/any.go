@@ -23,6 +23,9 @@ import (
 
 var any = &lint.FieldRule{
 	Name: lint.NewRuleName(146, "any"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Avoid `google.protobuf.Any` fields.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		return !utils.IsCommonProto(f.ParentFile())
 	},
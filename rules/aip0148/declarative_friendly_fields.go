@@ -26,6 +26,9 @@ import (
 
 var declarativeFriendlyRequired = &lint.MessageRule{
 	Name: lint.NewRuleName(148, "declarative-friendly-fields"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Declarative-friendly resources must include some standard fields.",
+	},
 	OnlyIf: func(m protoreflect.MessageDescriptor) bool {
 		if resource := utils.DeclarativeFriendlyResource(m); resource == m {
 			return true
@@ -23,6 +23,9 @@ import (
 
 var fieldBehavior = &lint.FieldRule{
 	Name: lint.NewRuleName(148, "field-behavior"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Standard resource fields should have the correct field behavior.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return utils.IsResource(m) && outputOnlyFields.Contains(string(f.Name()))
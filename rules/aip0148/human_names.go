@@ -24,6 +24,10 @@ import (
 
 var humanNames = &lint.FieldRule{
 	Name: lint.NewRuleName(148, "human-names"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Avoid imprecise terms for human names.",
+		Fixable:     true,
+	},
 	LintField: func(f protoreflect.FieldDescriptor) []lint.Problem {
 		for got, want := range corrections {
 			if string(f.Name()) == got {
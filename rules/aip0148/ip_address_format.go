@@ -31,6 +31,9 @@ var ipAddressFormats = []annotations.FieldInfo_Format{
 
 var ipAddressFormat = &lint.FieldRule{
 	Name: lint.NewRuleName(148, "ip-address-format"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Annotate IP address fields with an IP address format.",
+	},
 	OnlyIf: func(fd protoreflect.FieldDescriptor) bool {
 		return fd.Kind() == protoreflect.StringKind && (fd.Name() == "ip_address" || strings.HasSuffix(string(fd.Name()), "_ip_address"))
 	},
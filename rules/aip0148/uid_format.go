@@ -23,6 +23,9 @@ import (
 
 var uidFormat = &lint.FieldRule{
 	Name: lint.NewRuleName(148, "uid-format"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Annotate uid with UUID4 format.",
+	},
 	OnlyIf: func(fd protoreflect.FieldDescriptor) bool {
 		return fd.Kind() == protoreflect.StringKind && fd.Name() == uidStr
 	},
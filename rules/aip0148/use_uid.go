@@ -30,6 +30,10 @@ const (
 
 var useUID = &lint.FieldRule{
 	Name: lint.NewRuleName(148, "use-uid"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Use uid instead of id in resource messages.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return utils.IsResource(m)
@@ -21,7 +21,10 @@ import (
 )
 
 var lroMetadataReachable = &lint.MethodRule{
-	Name:   lint.NewRuleName(151, "lro-metadata-reachable"),
+	Name: lint.NewRuleName(151, "lro-metadata-reachable"),
+	RuleInfo: lint.RuleInfo{
+		Description: "LRO metadata messages must be reachable.",
+	},
 	OnlyIf: isAnnotatedLRO,
 	LintMethod: func(m protoreflect.MethodDescriptor) (problems []lint.Problem) {
 		// See lro_response_reachable.go for `checkReachable` method.
@@ -22,7 +22,10 @@ import (
 )
 
 var lroMetadata = &lint.MethodRule{
-	Name:   lint.NewRuleName(151, "lro-metadata-type"),
+	Name: lint.NewRuleName(151, "lro-metadata-type"),
+	RuleInfo: lint.RuleInfo{
+		Description: "LRO methods must have a metadata type.",
+	},
 	OnlyIf: isAnnotatedLRO,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		lro := utils.GetOperationInfo(m)
@@ -26,7 +26,10 @@ import (
 )
 
 var lroResponseReachable = &lint.MethodRule{
-	Name:   lint.NewRuleName(151, "lro-response-reachable"),
+	Name: lint.NewRuleName(151, "lro-response-reachable"),
+	RuleInfo: lint.RuleInfo{
+		Description: "LRO response messages must be reachable.",
+	},
 	OnlyIf: isAnnotatedLRO,
 	LintMethod: func(m protoreflect.MethodDescriptor) (problems []lint.Problem) {
 		return checkReachable(m, utils.GetOperationInfo(m).GetResponseType())
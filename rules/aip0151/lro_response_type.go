@@ -25,7 +25,10 @@ import (
 )
 
 var lroResponse = &lint.MethodRule{
-	Name:   lint.NewRuleName(151, "lro-response-type"),
+	Name: lint.NewRuleName(151, "lro-response-type"),
+	RuleInfo: lint.RuleInfo{
+		Description: "LRO methods must have a response type.",
+	},
 	OnlyIf: isAnnotatedLRO,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		lro := utils.GetOperationInfo(m)
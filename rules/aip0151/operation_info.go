@@ -21,7 +21,10 @@ import (
 )
 
 var lroAnnotationExists = &lint.MethodRule{
-	Name:   lint.NewRuleName(151, "operation-info"),
+	Name: lint.NewRuleName(151, "operation-info"),
+	RuleInfo: lint.RuleInfo{
+		Description: "LRO methods must include an `operation_info` annotation.",
+	},
 	OnlyIf: isLRO,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		if utils.GetOperationInfo(m) == nil {
@@ -21,7 +21,10 @@ import (
 )
 
 var responseUnary = &lint.MethodRule{
-	Name:   lint.NewRuleName(151, "response-unary"),
+	Name: lint.NewRuleName(151, "response-unary"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Long-running operations must not use streaming.",
+	},
 	OnlyIf: isLRO,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		if m.IsStreamingServer() {
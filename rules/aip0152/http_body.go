@@ -21,7 +21,10 @@ import (
 
 // Run methods should use "*" as the HTTP body.
 var httpBody = &lint.MethodRule{
-	Name:       lint.NewRuleName(152, "http-body"),
+	Name: lint.NewRuleName(152, "http-body"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Run methods should use `*` as the HTTP body.",
+	},
 	OnlyIf:     isRunMethod,
 	LintMethod: utils.LintWildcardHTTPBody,
 }
@@ -21,7 +21,10 @@ import (
 
 // Run methods should use the HTTP POST method.
 var httpMethod = &lint.MethodRule{
-	Name:       lint.NewRuleName(152, "http-method"),
+	Name: lint.NewRuleName(152, "http-method"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Run methods must use the POST HTTP verb.",
+	},
 	OnlyIf:     isRunMethod,
 	LintMethod: utils.LintHTTPMethod("POST"),
 }
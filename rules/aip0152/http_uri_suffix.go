@@ -23,7 +23,10 @@ import (
 
 // Run methods should have a proper HTTP pattern.
 var httpURISuffix = &lint.MethodRule{
-	Name:   lint.NewRuleName(152, "http-uri-suffix"),
+	Name: lint.NewRuleName(152, "http-uri-suffix"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Run methods must have the correct URI suffix",
+	},
 	OnlyIf: isRunMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		for _, httpRule := range utils.GetHTTPRules(m) {
@@ -21,7 +21,11 @@ import (
 
 // Run messages should have a properly named request message.
 var requestMessageName = &lint.MethodRule{
-	Name:       lint.NewRuleName(152, "request-message-name"),
+	Name: lint.NewRuleName(152, "request-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Run methods must have standardized request message names.",
+		Fixable:     true,
+	},
 	OnlyIf:     isRunMethod,
 	LintMethod: utils.LintMethodHasMatchingRequestName,
 }
@@ -20,7 +20,11 @@ import (
 )
 
 var requestNameField = &lint.MessageRule{
-	Name:        lint.NewRuleName(152, "request-name-field"),
+	Name: lint.NewRuleName(152, "request-name-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Run RPCs must have a `name` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf:      isRunRequestMessage,
 	LintMessage: utils.LintFieldPresentAndSingularString("name"),
 }
@@ -22,6 +22,9 @@ import (
 
 var requestNameReference = &lint.FieldRule{
 	Name: lint.NewRuleName(152, "request-name-reference"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Run requests should annotate the `name` field with `google.api.resource_reference`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		msg, ok := f.Parent().(protoreflect.MessageDescriptor)
 		return ok && isRunRequestMessage(msg) && string(f.Name()) == "name"
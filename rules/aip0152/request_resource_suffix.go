@@ -27,6 +27,10 @@ import (
 // The name of the resource must end with the word "Job".
 var requestResourceSuffix = &lint.FieldRule{
 	Name: lint.NewRuleName(152, "request-resource-suffix"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Run requests should identify a resource type which ends in \"Job\".",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		msg, ok := f.Parent().(protoreflect.MessageDescriptor)
 		return ok && isRunRequestMessage(msg) && string(f.Name()) == "name"
@@ -24,7 +24,11 @@ import (
 )
 
 var responseMessageName = &lint.MethodRule{
-	Name:   lint.NewRuleName(152, "response-message-name"),
+	Name: lint.NewRuleName(152, "response-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Run methods must return a long-running operation.",
+		Fixable:     true,
+	},
 	OnlyIf: isRunMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		if m.Output().FullName() != "google.longrunning.Operation" {
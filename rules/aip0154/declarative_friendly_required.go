@@ -25,6 +25,9 @@ import (
 
 var declarativeFriendlyRequired = &lint.MessageRule{
 	Name: lint.NewRuleName(154, "declarative-friendly-required"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Declarative-friendly resources must have an etag field.",
+	},
 	OnlyIf: func(m protoreflect.MessageDescriptor) bool {
 		// Sanity check: If the resource is not declarative-friendly, none of
 		// this logic applies.
@@ -25,6 +25,10 @@ import (
 
 var fieldType = &lint.FieldRule{
 	Name: lint.NewRuleName(154, "field-type"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Etag fields must be strings.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		return string(f.Name()) == "etag"
 	},
@@ -23,6 +23,10 @@ import (
 
 var noDuplicateEtag = &lint.FieldRule{
 	Name: lint.NewRuleName(154, "no-duplicate-etag"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Etag fields should not be set on request messages that include the resource.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		return string(f.Name()) == "etag" && strings.HasSuffix(string(f.Parent().Name()), "Request")
 	},
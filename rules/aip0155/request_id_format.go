@@ -23,6 +23,9 @@ import (
 
 var requestIDFormat = &lint.FieldRule{
 	Name: lint.NewRuleName(155, "request-id-format"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Annotate request_id with UUID4 format.",
+	},
 	OnlyIf: func(fd protoreflect.FieldDescriptor) bool {
 		return fd.Kind() == protoreflect.StringKind &&
 			fd.Name() == "request_id"
@@ -25,6 +25,9 @@ import (
 
 var forbiddenMethods = &lint.MethodRule{
 	Name: lint.NewRuleName(156, "forbidden-methods"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Singletons must not define Create, or Delete methods.",
+	},
 	OnlyIf: func(m protoreflect.MethodDescriptor) bool {
 		// If the `name` variable in the URI ends in something other than
 		// "*", that indicates that this is a singleton.
@@ -22,6 +22,10 @@ import (
 
 var requestReadMaskField = &lint.FieldRule{
 	Name: lint.NewRuleName(157, "request-read-mask-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Request read mask fields must have the correct type.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		msg, ok := f.Parent().(protoreflect.MessageDescriptor)
 		return ok && isRequestMessage(msg) && string(f.Name()) == "read_mask"
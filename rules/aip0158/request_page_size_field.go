@@ -21,7 +21,11 @@ import (
 )
 
 var requestPaginationPageSize = &lint.MessageRule{
-	Name:   lint.NewRuleName(158, "request-page-size-field"),
+	Name: lint.NewRuleName(158, "request-page-size-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Paginated RPCs must have a `page_size` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf: isPaginatedRequestMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		f, problems := utils.LintFieldPresent(m, "page_size")
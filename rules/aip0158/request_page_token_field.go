@@ -21,7 +21,11 @@ import (
 )
 
 var requestPaginationPageToken = &lint.MessageRule{
-	Name:   lint.NewRuleName(158, "request-page-token-field"),
+	Name: lint.NewRuleName(158, "request-page-token-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Paginated RPCs must have a `page_token` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf: isPaginatedRequestMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		f, problems := utils.LintFieldPresent(m, "page_token")
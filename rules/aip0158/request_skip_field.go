@@ -22,6 +22,10 @@ import (
 
 var requestSkipField = &lint.FieldRule{
 	Name: lint.NewRuleName(158, "request-skip-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Paginated RPC `skip` fields must have type `int32`.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		return isPaginatedRequestMessage(f.Parent().(protoreflect.MessageDescriptor)) && f.Name() == "skip"
 	},
@@ -20,7 +20,11 @@ import (
 )
 
 var responsePaginationNextPageToken = &lint.MessageRule{
-	Name:        lint.NewRuleName(158, "response-next-page-token-field"),
+	Name: lint.NewRuleName(158, "response-next-page-token-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Paginated RPCs must have a `next_page_token` field in the response.",
+		Fixable:     true,
+	},
 	OnlyIf:      isPaginatedResponseMessage,
 	LintMessage: utils.LintFieldPresentAndSingularString("next_page_token"),
 }
@@ -24,6 +24,10 @@ import (
 
 var responsePluralFirstField = &lint.MessageRule{
 	Name: lint.NewRuleName(158, "response-plural-first-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "First field of Paginated RPCs' response should be plural.",
+		Fixable:     true,
+	},
 	OnlyIf: func(m protoreflect.MessageDescriptor) bool {
 		return isPaginatedResponseMessage(m) && m.Fields().Len() > 0
 	},
@@ -21,6 +21,9 @@ import (
 
 var responseRepeatedFirstField = &lint.MessageRule{
 	Name: lint.NewRuleName(158, "response-repeated-first-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "First field (by both position and field number) of Paginated RPCs' response should be repeated.",
+	},
 	OnlyIf: func(m protoreflect.MessageDescriptor) bool {
 		return isPaginatedResponseMessage(m) && m.Fields().Len() > 0
 	},
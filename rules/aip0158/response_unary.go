@@ -21,7 +21,10 @@ import (
 )
 
 var responseUnary = &lint.MethodRule{
-	Name:   lint.NewRuleName(158, "response-unary"),
+	Name: lint.NewRuleName(158, "response-unary"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Paginated responses must not use streaming.",
+	},
 	OnlyIf: isPaginatedMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		if m.IsStreamingServer() {
@@ -25,6 +25,9 @@ import (
 
 var hardcodedHyphen = &lint.MethodRule{
 	Name: lint.NewRuleName(159, "hardcoded-hyphen"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Request URIs must not hard-code a `-` segment.",
+	},
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		for _, http := range utils.GetHTTPRules(m) {
 			if strings.Contains(http.GetPlainURI(), "/-/") {
@@ -30,6 +30,10 @@ const (
 
 var filterFieldName = &lint.MethodRule{
 	Name: lint.NewRuleName(160, "filter-field-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "The filtering field on List and custom method request messages must be called \"filter\" and not \"filters\".",
+		Fixable:     true,
+	},
 	OnlyIf: func(m protoreflect.MethodDescriptor) bool {
 		return utils.IsListMethod(m) || utils.IsCustomMethod(m)
 	},
@@ -30,6 +30,10 @@ const (
 
 var filterFieldType = &lint.MethodRule{
 	Name: lint.NewRuleName(160, "filter-field-type"),
+	RuleInfo: lint.RuleInfo{
+		Description: "The filtering field on List and custom method request messages, \"filter\" must be a string.",
+		Fixable:     true,
+	},
 	OnlyIf: func(m protoreflect.MethodDescriptor) bool {
 		return utils.IsListMethod(m) || utils.IsCustomMethod(m)
 	},
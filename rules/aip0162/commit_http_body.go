@@ -21,7 +21,10 @@ import (
 
 // Commit methods should have "*" as the HTTP body.
 var commitHTTPBody = &lint.MethodRule{
-	Name:       lint.NewRuleName(162, "commit-http-body"),
+	Name: lint.NewRuleName(162, "commit-http-body"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Commit methods should use `*` as the HTTP body.",
+	},
 	OnlyIf:     utils.IsCommitRevisionMethod,
 	LintMethod: utils.LintWildcardHTTPBody,
 }
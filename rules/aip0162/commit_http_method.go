@@ -21,7 +21,10 @@ import (
 
 // Commit methods should use the HTTP POST method.
 var commitHTTPMethod = &lint.MethodRule{
-	Name:       lint.NewRuleName(162, "commit-http-method"),
+	Name: lint.NewRuleName(162, "commit-http-method"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Commit methods must use the POST HTTP verb.",
+	},
 	OnlyIf:     utils.IsCommitRevisionMethod,
 	LintMethod: utils.LintHTTPMethod("POST"),
 }
@@ -23,7 +23,10 @@ import (
 
 // Commit methods should have a proper HTTP pattern.
 var commitHTTPURISuffix = &lint.MethodRule{
-	Name:   lint.NewRuleName(162, "commit-http-uri-suffix"),
+	Name: lint.NewRuleName(162, "commit-http-uri-suffix"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Commit methods must have the correct URI suffix",
+	},
 	OnlyIf: utils.IsCommitRevisionMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		for _, httpRule := range utils.GetHTTPRules(m) {
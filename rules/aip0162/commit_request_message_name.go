@@ -21,7 +21,11 @@ import (
 
 // Commit messages should have a properly named request message.
 var commitRequestMessageName = &lint.MethodRule{
-	Name:       lint.NewRuleName(162, "commit-request-message-name"),
+	Name: lint.NewRuleName(162, "commit-request-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Commit methods must have standardized request message names.",
+		Fixable:     true,
+	},
 	OnlyIf:     utils.IsCommitRevisionMethod,
 	LintMethod: utils.LintMethodHasMatchingRequestName,
 }
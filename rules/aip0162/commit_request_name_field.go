@@ -21,7 +21,11 @@ import (
 
 // The Commit request message should have a name field.
 var commitRequestNameField = &lint.MessageRule{
-	Name:        lint.NewRuleName(162, "commit-request-name-field"),
+	Name: lint.NewRuleName(162, "commit-request-name-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Commit RPCs must have a `name` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf:      isCommitRequestMessage,
 	LintMessage: utils.LintFieldPresentAndSingularString("name"),
 }
@@ -22,6 +22,9 @@ import (
 
 var commitRequestNameReference = &lint.FieldRule{
 	Name: lint.NewRuleName(162, "commit-request-name-reference"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Commit requests should annotate the `name` field with `google.api.resource_reference`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		msg, ok := f.Parent().(protoreflect.MessageDescriptor)
 		return ok && isCommitRequestMessage(msg) && string(f.Name()) == "name"
@@ -24,7 +24,11 @@ import (
 )
 
 var commitResponseMessageName = &lint.MethodRule{
-	Name:   lint.NewRuleName(162, "commit-response-message-name"),
+	Name: lint.NewRuleName(162, "commit-response-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Commit methods must have standardized response message names.",
+		Fixable:     true,
+	},
 	OnlyIf: utils.IsCommitRevisionMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		// Rule check: Establish that for methods such as `CommitBook`, the response
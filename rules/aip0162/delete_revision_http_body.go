@@ -21,7 +21,10 @@ import (
 
 // Delete Revision methods should have no HTTP body.
 var deleteRevisionHTTPBody = &lint.MethodRule{
-	Name:       lint.NewRuleName(162, "delete-revision-http-body"),
+	Name: lint.NewRuleName(162, "delete-revision-http-body"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Delete Revision methods should not have an HTTP body.",
+	},
 	OnlyIf:     utils.IsDeleteRevisionMethod,
 	LintMethod: utils.LintNoHTTPBody,
 }
@@ -21,7 +21,10 @@ import (
 
 // Delete Revision methods should use the HTTP DELETE method.
 var deleteRevisionHTTPMethod = &lint.MethodRule{
-	Name:       lint.NewRuleName(162, "delete-revision-http-method"),
+	Name: lint.NewRuleName(162, "delete-revision-http-method"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Delete Revision methods must use the DELETE HTTP verb.",
+	},
 	OnlyIf:     utils.IsDeleteRevisionMethod,
 	LintMethod: utils.LintHTTPMethod("DELETE"),
 }
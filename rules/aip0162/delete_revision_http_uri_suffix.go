@@ -23,7 +23,10 @@ import (
 
 // Delete Revision methods should have a proper HTTP pattern.
 var deleteRevisionHTTPURISuffix = &lint.MethodRule{
-	Name:   lint.NewRuleName(162, "delete-revision-http-uri-suffix"),
+	Name: lint.NewRuleName(162, "delete-revision-http-uri-suffix"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Delete Revision methods must have the correct URI suffix",
+	},
 	OnlyIf: utils.IsDeleteRevisionMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		for _, httpRule := range utils.GetHTTPRules(m) {
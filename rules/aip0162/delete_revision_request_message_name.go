@@ -21,7 +21,11 @@ import (
 
 // Delete Revision messages should have a properly named request message.
 var deleteRevisionRequestMessageName = &lint.MethodRule{
-	Name:       lint.NewRuleName(162, "delete-revision-request-message-name"),
+	Name: lint.NewRuleName(162, "delete-revision-request-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Delete Revision methods must have standardized request message names.",
+		Fixable:     true,
+	},
 	OnlyIf:     utils.IsDeleteRevisionMethod,
 	LintMethod: utils.LintMethodHasMatchingRequestName,
 }
@@ -22,6 +22,9 @@ import (
 
 var deleteRevisionRequestNameBehavior = &lint.FieldRule{
 	Name: lint.NewRuleName(162, "delete-revision-request-name-behavior"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Delete Revision requests should annotate the `name` field with `google.api.field_behavior`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		msg, ok := f.Parent().(protoreflect.MessageDescriptor)
 		return ok && isDeleteRevisionRequestMessage(msg) && string(f.Name()) == "name"
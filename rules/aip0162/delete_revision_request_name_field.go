@@ -21,7 +21,11 @@ import (
 
 // The Delete Revision request message should have a name field.
 var deleteRevisionRequestNameField = &lint.MessageRule{
-	Name:        lint.NewRuleName(162, "delete-revision-request-name-field"),
+	Name: lint.NewRuleName(162, "delete-revision-request-name-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Delete Revision RPCs must have a `name` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf:      isDeleteRevisionRequestMessage,
 	LintMessage: utils.LintFieldPresentAndSingularString("name"),
 }
@@ -25,7 +25,11 @@ import (
 
 // Delete Revision methods should return the resource itself.
 var deleteRevisionResponseMessageName = &lint.MethodRule{
-	Name:   lint.NewRuleName(162, "delete-revision-response-message-name"),
+	Name: lint.NewRuleName(162, "delete-revision-response-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Delete Revision methods must return the resource.",
+		Fixable:     true,
+	},
 	OnlyIf: utils.IsDeleteRevisionMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		want, ok := utils.ExtractRevisionResource(m)
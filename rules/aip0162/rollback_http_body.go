@@ -21,7 +21,10 @@ import (
 
 // Rollback methods should have "*" as the HTTP body.
 var rollbackHTTPBody = &lint.MethodRule{
-	Name:       lint.NewRuleName(162, "rollback-http-body"),
+	Name: lint.NewRuleName(162, "rollback-http-body"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Rollback methods should use `*` as the HTTP body.",
+	},
 	OnlyIf:     utils.IsRollbackRevisionMethod,
 	LintMethod: utils.LintWildcardHTTPBody,
 }
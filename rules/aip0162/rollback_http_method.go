@@ -21,7 +21,10 @@ import (
 
 // Rollback methods should use the HTTP POST method.
 var rollbackHTTPMethod = &lint.MethodRule{
-	Name:       lint.NewRuleName(162, "rollback-http-method"),
+	Name: lint.NewRuleName(162, "rollback-http-method"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Rollback methods must use the POST HTTP verb.",
+	},
 	OnlyIf:     utils.IsRollbackRevisionMethod,
 	LintMethod: utils.LintHTTPMethod("POST"),
 }
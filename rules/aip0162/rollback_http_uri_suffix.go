@@ -23,7 +23,10 @@ import (
 
 // Rollback methods should have a proper HTTP pattern.
 var rollbackHTTPURISuffix = &lint.MethodRule{
-	Name:   lint.NewRuleName(162, "rollback-http-uri-suffix"),
+	Name: lint.NewRuleName(162, "rollback-http-uri-suffix"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Rollback methods must have the correct URI suffix",
+	},
 	OnlyIf: utils.IsRollbackRevisionMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		for _, httpRule := range utils.GetHTTPRules(m) {
@@ -21,7 +21,11 @@ import (
 
 // Rollback messages should have a properly named request message.
 var rollbackRequestMessageName = &lint.MethodRule{
-	Name:       lint.NewRuleName(162, "rollback-request-message-name"),
+	Name: lint.NewRuleName(162, "rollback-request-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Rollback methods must have standardized request message names.",
+		Fixable:     true,
+	},
 	OnlyIf:     utils.IsRollbackRevisionMethod,
 	LintMethod: utils.LintMethodHasMatchingRequestName,
 }
@@ -21,7 +21,11 @@ import (
 
 // The Rollback request message should have a name field.
 var rollbackRequestNameField = &lint.MessageRule{
-	Name:        lint.NewRuleName(162, "rollback-request-name-field"),
+	Name: lint.NewRuleName(162, "rollback-request-name-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Rollback RPCs must have a `name` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf:      isRollbackRequestMessage,
 	LintMessage: utils.LintFieldPresentAndSingularString("name"),
 }
@@ -22,6 +22,9 @@ import (
 
 var rollbackRequestRevisionIDBehavior = &lint.FieldRule{
 	Name: lint.NewRuleName(162, "rollback-request-revision-id-behavior"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Rollback requests should annotate the `revision_id` field with `google.api.field_behavior`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		msg, ok := f.Parent().(protoreflect.MessageDescriptor)
 		return ok && isRollbackRequestMessage(msg) && string(f.Name()) == "revision_id"
@@ -21,7 +21,11 @@ import (
 
 // The Rollback request message should have a revision_id field.
 var rollbackRequestRevisionIDField = &lint.MessageRule{
-	Name:        lint.NewRuleName(162, "rollback-request-revision-id-field"),
+	Name: lint.NewRuleName(162, "rollback-request-revision-id-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Rollback RPCs must have a `revision_id` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf:      isRollbackRequestMessage,
 	LintMessage: utils.LintFieldPresentAndSingularString("revision_id"),
 }
@@ -24,7 +24,11 @@ import (
 )
 
 var rollbackResponseMessageName = &lint.MethodRule{
-	Name:   lint.NewRuleName(162, "rollback-response-message-name"),
+	Name: lint.NewRuleName(162, "rollback-response-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Rollback methods must have standardized response message names.",
+		Fixable:     true,
+	},
 	OnlyIf: utils.IsRollbackRevisionMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		// Rule check: Establish that for methods such as `RollbackBook`, the response
@@ -21,7 +21,10 @@ import (
 
 // Tag Revision methods should have "*" as the HTTP body.
 var tagRevisionHTTPBody = &lint.MethodRule{
-	Name:       lint.NewRuleName(162, "tag-revision-http-body"),
+	Name: lint.NewRuleName(162, "tag-revision-http-body"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Tag Revision methods should use `*` as the HTTP body.",
+	},
 	OnlyIf:     utils.IsTagRevisionMethod,
 	LintMethod: utils.LintWildcardHTTPBody,
 }
@@ -21,7 +21,10 @@ import (
 
 // Tag Revision methods should use the HTTP POST method.
 var tagRevisionHTTPMethod = &lint.MethodRule{
-	Name:       lint.NewRuleName(162, "tag-revision-http-method"),
+	Name: lint.NewRuleName(162, "tag-revision-http-method"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Tag Revision methods must use the POST HTTP verb.",
+	},
 	OnlyIf:     utils.IsTagRevisionMethod,
 	LintMethod: utils.LintHTTPMethod("POST"),
 }
@@ -23,7 +23,10 @@ import (
 
 // Tag Revision methods should have a proper HTTP pattern.
 var tagRevisionHTTPURISuffix = &lint.MethodRule{
-	Name:   lint.NewRuleName(162, "tag-revision-http-uri-suffix"),
+	Name: lint.NewRuleName(162, "tag-revision-http-uri-suffix"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Tag Revision methods must have the correct URI suffix",
+	},
 	OnlyIf: utils.IsTagRevisionMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		for _, httpRule := range utils.GetHTTPRules(m) {
@@ -21,7 +21,11 @@ import (
 
 // Tag Revision messages should have a properly named request message.
 var tagRevisionRequestMessageName = &lint.MethodRule{
-	Name:       lint.NewRuleName(162, "tag-revision-request-message-name"),
+	Name: lint.NewRuleName(162, "tag-revision-request-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Tag Revision methods must have standardized request message names.",
+		Fixable:     true,
+	},
 	OnlyIf:     utils.IsTagRevisionMethod,
 	LintMethod: utils.LintMethodHasMatchingRequestName,
 }
@@ -22,6 +22,9 @@ import (
 
 var tagRevisionRequestNameBehavior = &lint.FieldRule{
 	Name: lint.NewRuleName(162, "tag-revision-request-name-behavior"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Tag Revision requests should annotate the `name` field with `google.api.field_behavior`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		msg, ok := f.Parent().(protoreflect.MessageDescriptor)
 		return ok && isTagRevisionRequestMessage(msg) && string(f.Name()) == "name"
@@ -21,7 +21,11 @@ import (
 
 // The Tag Revision request message should have a name field.
 var tagRevisionRequestNameField = &lint.MessageRule{
-	Name:        lint.NewRuleName(162, "tag-revision-request-name-field"),
+	Name: lint.NewRuleName(162, "tag-revision-request-name-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Tag Revision RPCs must have a `name` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf:      isTagRevisionRequestMessage,
 	LintMessage: utils.LintFieldPresentAndSingularString("name"),
 }
@@ -21,7 +21,11 @@ import (
 
 // The Tag Revision request message should have a tag field.
 var tagRevisionRequestTagField = &lint.MessageRule{
-	Name:        lint.NewRuleName(162, "tag-revision-request-tag-field"),
+	Name: lint.NewRuleName(162, "tag-revision-request-tag-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Tag Revision RPCs must have a `tag` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf:      isTagRevisionRequestMessage,
 	LintMessage: utils.LintFieldPresentAndSingularString("tag"),
 }
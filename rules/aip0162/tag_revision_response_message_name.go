@@ -24,7 +24,11 @@ import (
 )
 
 var tagRevisionResponseMessageName = &lint.MethodRule{
-	Name:   lint.NewRuleName(162, "tag-revision-response-message-name"),
+	Name: lint.NewRuleName(162, "tag-revision-response-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Tag Revision methods must have standardized response message names.",
+		Fixable:     true,
+	},
 	OnlyIf: utils.IsTagRevisionMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		// Rule check: Establish that for methods such as `TagBookRevision`, the response
@@ -24,6 +24,9 @@ import (
 
 var declarativeFriendlyRequired = &lint.MessageRule{
 	Name: lint.NewRuleName(163, "declarative-friendly-required"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Declarative-friendly mutations should have a validate_only field.",
+	},
 	OnlyIf: func(m protoreflect.MessageDescriptor) bool {
 		// We only want to look at request methods, not the resources themselves.
 		if name := string(m.Name()); strings.HasSuffix(name, "Request") && utils.IsDeclarativeFriendlyMessage(m) {
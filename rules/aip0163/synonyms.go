@@ -22,6 +22,10 @@ import (
 
 var synonyms = &lint.FieldRule{
 	Name: lint.NewRuleName(163, "synonyms"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Change validation fields should be named `validate_only`.",
+		Fixable:     true,
+	},
 	LintField: func(f protoreflect.FieldDescriptor) []lint.Problem {
 		if string(f.Name()) == "dry_run" {
 			return []lint.Problem{{
@@ -21,7 +21,10 @@ import (
 
 // Undelete methods should have "*" as the HTTP body.
 var httpBody = &lint.MethodRule{
-	Name:       lint.NewRuleName(164, "http-body"),
+	Name: lint.NewRuleName(164, "http-body"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Undelete methods should use `*` as the HTTP body.",
+	},
 	OnlyIf:     isUndeleteMethod,
 	LintMethod: utils.LintWildcardHTTPBody,
 }
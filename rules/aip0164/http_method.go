@@ -21,7 +21,10 @@ import (
 
 // Undelete methods should use the HTTP POST method.
 var httpMethod = &lint.MethodRule{
-	Name:       lint.NewRuleName(164, "http-method"),
+	Name: lint.NewRuleName(164, "http-method"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Undelete methods must use the POST HTTP verb.",
+	},
 	OnlyIf:     isUndeleteMethod,
 	LintMethod: utils.LintHTTPMethod("POST"),
 }
@@ -23,7 +23,10 @@ import (
 
 // Undelete methods should have a proper HTTP pattern.
 var httpURISuffix = &lint.MethodRule{
-	Name:   lint.NewRuleName(164, "http-uri-suffix"),
+	Name: lint.NewRuleName(164, "http-uri-suffix"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Undelete methods must have the correct URI suffix",
+	},
 	OnlyIf: isUndeleteMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		for _, httpRule := range utils.GetHTTPRules(m) {
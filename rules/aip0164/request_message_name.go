@@ -21,7 +21,11 @@ import (
 
 // Undelete messages should have a properly named request message.
 var requestMessageName = &lint.MethodRule{
-	Name:       lint.NewRuleName(164, "request-message-name"),
+	Name: lint.NewRuleName(164, "request-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Undelete methods must have standardized request message names.",
+		Fixable:     true,
+	},
 	OnlyIf:     isUndeleteMethod,
 	LintMethod: utils.LintMethodHasMatchingRequestName,
 }
@@ -22,6 +22,9 @@ import (
 
 var requestNameBehavior = &lint.FieldRule{
 	Name: lint.NewRuleName(164, "request-name-behavior"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Undelete RPCs should annotate the `name` field with `google.api.field_behavior`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		msg, ok := f.Parent().(protoreflect.MessageDescriptor)
 		return ok && isUndeleteRequestMessage(msg) && string(f.Name()) == "name"
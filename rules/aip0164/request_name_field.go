@@ -20,7 +20,11 @@ import (
 )
 
 var requestNameField = &lint.MessageRule{
-	Name:        lint.NewRuleName(164, "request-name-field"),
+	Name: lint.NewRuleName(164, "request-name-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Undelete RPCs must have a `name` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf:      isUndeleteRequestMessage,
 	LintMessage: utils.LintFieldPresentAndSingularString("name"),
 }
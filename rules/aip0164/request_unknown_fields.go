@@ -23,7 +23,10 @@ import (
 
 // Undelete methods should not have unrecognized fields.
 var requestUnknownFields = &lint.MessageRule{
-	Name:   lint.NewRuleName(164, "request-unknown-fields"),
+	Name: lint.NewRuleName(164, "request-unknown-fields"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Undelete RPCs should not have unexpected fields in the request.",
+	},
 	OnlyIf: isUndeleteRequestMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) (problems []lint.Problem) {
 		// Rule check: Establish that there are no unexpected fields.
@@ -9,6 +9,9 @@ import (
 // Resources supporting soft delete must have an expire_time field.
 var resourceExpireTimeField = &lint.MessageRule{
 	Name: lint.NewRuleName(164, "resource-expire-time-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resources supporting soft delete must have an `expire_time` field.",
+	},
 	OnlyIf: func(m protoreflect.MessageDescriptor) bool {
 		resource := string(m.Name())
 		file, ok := m.Parent().(protoreflect.FileDescriptor)
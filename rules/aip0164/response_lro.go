@@ -23,6 +23,10 @@ import (
 
 var responseLRO = &lint.MethodRule{
 	Name: lint.NewRuleName(164, "response-lro"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Declarative-friendly undelete methods should use long-running operations.",
+		Fixable:     true,
+	},
 	OnlyIf: func(m protoreflect.MethodDescriptor) bool {
 		return isUndeleteMethod(m) && utils.IsDeclarativeFriendlyMethod(m)
 	},
@@ -27,7 +27,11 @@ import (
 // Undelete messages should use google.longrunning.Operation
 // or the resource itself as the response message.
 var responseMessageName = &lint.MethodRule{
-	Name:   lint.NewRuleName(164, "response-message-name"),
+	Name: lint.NewRuleName(164, "response-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Undelete methods must return the resource.",
+		Fixable:     true,
+	},
 	OnlyIf: isUndeleteMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		// Rule check: Establish that for methods such as `UndeleteFoo`, the response
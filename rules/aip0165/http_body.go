@@ -21,7 +21,10 @@ import (
 
 // Purge methods should have "*" as the HTTP body.
 var httpBody = &lint.MethodRule{
-	Name:       lint.NewRuleName(165, "http-body"),
+	Name: lint.NewRuleName(165, "http-body"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Purge methods should use `*` as the HTTP body.",
+	},
 	OnlyIf:     isPurgeMethod,
 	LintMethod: utils.LintWildcardHTTPBody,
 }
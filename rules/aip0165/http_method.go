@@ -21,7 +21,10 @@ import (
 
 // Purge methods should use the HTTP POST method.
 var httpMethod = &lint.MethodRule{
-	Name:       lint.NewRuleName(165, "http-method"),
+	Name: lint.NewRuleName(165, "http-method"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Purge methods must use the POST HTTP verb.",
+	},
 	OnlyIf:     isPurgeMethod,
 	LintMethod: utils.LintHTTPMethod("POST"),
 }
@@ -23,6 +23,9 @@ import (
 // Purge methods should have a parent variable in the URI unless the resource is top-level.
 var httpParentVariable = &lint.MethodRule{
 	Name: lint.NewRuleName(165, "http-parent-variable"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Purge methods must map the parent field to the URI.",
+	},
 	OnlyIf: func(m protoreflect.MethodDescriptor) bool {
 		return isPurgeMethod(m) && m.Input().Fields().ByName("parent") != nil
 	},
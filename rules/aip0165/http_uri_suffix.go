@@ -23,7 +23,10 @@ import (
 
 // Purge methods should have a proper HTTP pattern.
 var httpURISuffix = &lint.MethodRule{
-	Name:   lint.NewRuleName(165, "http-uri-suffix"),
+	Name: lint.NewRuleName(165, "http-uri-suffix"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Purge methods must have the correct URI suffix",
+	},
 	OnlyIf: isPurgeMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		for _, httpRule := range utils.GetHTTPRules(m) {
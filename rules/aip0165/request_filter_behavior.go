@@ -22,6 +22,9 @@ import (
 
 var requestFilterBehavior = &lint.FieldRule{
 	Name: lint.NewRuleName(165, "request-filter-behavior"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Purge requests should annotate the `filter` field with `google.api.field_behavior`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		return isPurgeRequestMessage(f.Parent().(protoreflect.MessageDescriptor)) && string(f.Name()) == "filter"
 	},
@@ -21,7 +21,11 @@ import (
 
 // The Purge request message should have filter field.
 var requestFilterField = &lint.MessageRule{
-	Name:        lint.NewRuleName(165, "request-filter-field"),
+	Name: lint.NewRuleName(165, "request-filter-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Purge RPCs must have a `filter` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf:      isPurgeRequestMessage,
 	LintMessage: utils.LintFieldPresentAndSingularString("filter"),
 }
@@ -24,7 +24,11 @@ import (
 
 // The Purge request message should have force field.
 var requestForceField = &lint.MessageRule{
-	Name:   lint.NewRuleName(165, "request-force-field"),
+	Name: lint.NewRuleName(165, "request-force-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Purge RPCs must have a `force` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf: isPurgeRequestMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		// Rule check: Establish that a `force` field is present.
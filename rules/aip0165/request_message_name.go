@@ -21,7 +21,11 @@ import (
 
 // Purge messages should have a properly named request message.
 var requestMessageName = &lint.MethodRule{
-	Name:       lint.NewRuleName(165, "request-message-name"),
+	Name: lint.NewRuleName(165, "request-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Purge methods must have standardized request message names.",
+		Fixable:     true,
+	},
 	OnlyIf:     isPurgeMethod,
 	LintMethod: utils.LintMethodHasMatchingRequestName,
 }
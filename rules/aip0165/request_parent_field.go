@@ -27,6 +27,10 @@ import (
 // The Purge request message should have parent field.
 var requestParentField = &lint.MessageRule{
 	Name: lint.NewRuleName(165, "request-parent-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Purge RPCs must have a `parent` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf: func(m protoreflect.MessageDescriptor) bool {
 		// Sanity check: If the resource has a pattern, and that pattern
 		// contains no variables, then a parent field is not expected.
@@ -22,6 +22,9 @@ import (
 
 var requestParentReference = &lint.FieldRule{
 	Name: lint.NewRuleName(165, "request-parent-reference"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Purge requests should annotate the `parent` field with `google.api.resource_reference`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		return isPurgeRequestMessage(f.Parent().(protoreflect.MessageDescriptor)) && string(f.Name()) == "parent"
 	},
@@ -24,7 +24,11 @@ import (
 )
 
 var responseMessageName = &lint.MethodRule{
-	Name:   lint.NewRuleName(165, "response-message-name"),
+	Name: lint.NewRuleName(165, "response-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Purge methods must return a long-running operation.",
+		Fixable:     true,
+	},
 	OnlyIf: isPurgeMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		if m.Output().FullName() != "google.longrunning.Operation" {
@@ -24,7 +24,11 @@ import (
 
 // The Purge response message should have purge_count field.
 var responsePurgeCountField = &lint.MessageRule{
-	Name:   lint.NewRuleName(165, "response-purge-count-field"),
+	Name: lint.NewRuleName(165, "response-purge-count-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Purge RPCs must have a `purge_count` field in the response.",
+		Fixable:     true,
+	},
 	OnlyIf: isPurgeResponseMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		// Rule check: Establish that a `purge_count` field is present.
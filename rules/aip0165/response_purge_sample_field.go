@@ -24,7 +24,11 @@ import (
 
 // The Purge response message should have purge_sample field.
 var responsePurgeSampleField = &lint.MessageRule{
-	Name:   lint.NewRuleName(165, "response-purge-sample-field"),
+	Name: lint.NewRuleName(165, "response-purge-sample-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Purge RPCs must have a `purge_sample` field in the response.",
+		Fixable:     true,
+	},
 	OnlyIf: isPurgeResponseMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		// Rule check: Establish that a `purge_sample` field is present.
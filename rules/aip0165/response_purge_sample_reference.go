@@ -22,6 +22,9 @@ import (
 
 var responsePurgeSampleReference = &lint.FieldRule{
 	Name: lint.NewRuleName(165, "response-purge-sample-reference"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Purge responses should annotate the `purge_sample` field with `google.api.resource_reference`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		return isPurgeResponseMessage(f.Parent().(protoreflect.MessageDescriptor)) && string(f.Name()) == "purge_sample"
 	},
@@ -23,6 +23,9 @@ import (
 
 var messageCase = &lint.MessageRule{
 	Name: lint.NewRuleName(190, "message-case"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Message names must use UpperCamelCase.",
+	},
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		name := string(m.Name())
 		if !isValidCamelCase(name) {
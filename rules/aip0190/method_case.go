@@ -23,6 +23,9 @@ import (
 
 var methodCase = &lint.MethodRule{
 	Name: lint.NewRuleName(190, "method-case"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Method names must use UpperCamelCase.",
+	},
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		name := string(m.Name())
 		if !isValidCamelCase(name) {
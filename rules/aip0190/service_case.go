@@ -23,6 +23,9 @@ import (
 
 var serviceCase = &lint.ServiceRule{
 	Name: lint.NewRuleName(190, "service-case"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Service names must use UpperCamelCase.",
+	},
 	LintService: func(s protoreflect.ServiceDescriptor) []lint.Problem {
 		name := string(s.Name())
 		if !isValidCamelCase(name) {
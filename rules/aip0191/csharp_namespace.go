@@ -30,6 +30,10 @@ import (
 
 var csharpNamespace = &lint.FileRule{
 	Name: lint.NewRuleName(191, "csharp-namespace"),
+	RuleInfo: lint.RuleInfo{
+		Description: "The `option csharp_namespace` annotation should be idiomatic if set.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FileDescriptor) bool {
 		return f.Options().(*dpb.FileOptions).GetCsharpNamespace() != ""
 	},
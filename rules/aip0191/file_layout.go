@@ -21,6 +21,9 @@ import (
 
 var fileLayout = &lint.FileRule{
 	Name: lint.NewRuleName(191, "file-layout"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Proto files should follow a consistent layout.",
+	},
 	LintFile: func(f protoreflect.FileDescriptor) (problems []lint.Problem) {
 		// Verify that services precede messages.
 		if f.Messages().Len() > 0 {
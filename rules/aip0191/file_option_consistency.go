@@ -38,43 +38,75 @@ var consistentOptions = map[string]func(*dpb.FileOptions) string{
 	"swift_prefix":           func(o *dpb.FileOptions) string { return o.GetSwiftPrefix() },
 }
 
-var fileOptionConsistency = &lint.FileRule{
-	Name:   lint.NewRuleName(191, "file-option-consistency"),
+var fileOptionConsistency = &lint.APIRule{
+	Name: lint.NewRuleName(191, "file-option-consistency"),
+	RuleInfo: lint.RuleInfo{
+		Description: "All proto files must set file packaging options consistently.",
+	},
 	OnlyIf: hasPackage,
-	LintFile: func(f protoreflect.FileDescriptor) (problems []lint.Problem) {
-		opts := f.Options().(*dpb.FileOptions)
-		for i := 0; i < f.Imports().Len(); i++ {
-			dep := f.Imports().Get(i)
-			// We only need to look at files that are in the same package
-			// as the proto we are linting.
-			if dep.Package() != f.Package() {
-				continue
-			}
+	LintFiles: func(files []protoreflect.FileDescriptor) (problems []lint.Problem) {
+		for _, f := range files {
+			problems = append(problems, lintFileOptions(f, samePackageFiles(f, files))...)
+		}
+		return
+	},
+}
 
-			// The file package options should all match between this file
-			// and the file being imported.
-			//
-			// We will naively complain on *this* file, even though either one
-			// might be the one that is wrong, and trust the API producer to do
-			// the right thing.
-			depOpts := dep.Options().(*dpb.FileOptions)
-			for opt, valueFunc := range consistentOptions {
-				if valueFunc(opts) != valueFunc(depOpts) {
-					problems = append(problems, lint.Problem{
-						Message:    fmt.Sprintf("Option %q should be consistent throughout the package.", opt),
-						Descriptor: f,
-						Location:   locations.FilePackage(f),
-					})
+// samePackageFiles returns the files of the API and the files imported by f
+// that are in the same package as f, except f itself.
+func samePackageFiles(f protoreflect.FileDescriptor, api []protoreflect.FileDescriptor) []protoreflect.FileDescriptor {
+	seen := map[string]bool{f.Path(): true}
+	var others []protoreflect.FileDescriptor
+	add := func(other protoreflect.FileDescriptor) {
+		// We only need to look at files that are in the same package
+		// as the proto we are linting.
+		if other.Package() == f.Package() && !seen[other.Path()] {
+			seen[other.Path()] = true
+			others = append(others, other)
+		}
+	}
+	for _, other := range api {
+		add(other)
+	}
+	for i := 0; i < f.Imports().Len(); i++ {
+		add(f.Imports().Get(i).FileDescriptor)
+	}
+	return others
+}
 
-					// Sort the problems. It does not matter for actual use, but
-					// testing is hard without it since maps are iterated in randomized
-					// order.
-					sort.Slice(problems, func(i, j int) bool {
-						return problems[i].Message < problems[j].Message
-					})
-				}
+// lintFileOptions reports the packaging options of f that differ from those
+// of any of the other files.
+func lintFileOptions(f protoreflect.FileDescriptor, others []protoreflect.FileDescriptor) (problems []lint.Problem) {
+	opts := f.Options().(*dpb.FileOptions)
+	inconsistent := map[string]bool{}
+	for _, other := range others {
+		// The file package options should all match between this file
+		// and the other ones.
+		//
+		// We will naively complain on *this* file, even though either one
+		// might be the one that is wrong, and trust the API producer to do
+		// the right thing.
+		otherOpts := other.Options().(*dpb.FileOptions)
+		for opt, valueFunc := range consistentOptions {
+			if valueFunc(opts) != valueFunc(otherOpts) {
+				inconsistent[opt] = true
 			}
 		}
-		return
-	},
+	}
+
+	// Sort the problems. It does not matter for actual use, but testing is
+	// hard without it since maps are iterated in randomized order.
+	var names []string
+	for opt := range inconsistent {
+		names = append(names, opt)
+	}
+	sort.Strings(names)
+	for _, opt := range names {
+		problems = append(problems, lint.Problem{
+			Message:    fmt.Sprintf("Option %q should be consistent throughout the package.", opt),
+			Descriptor: f,
+			Location:   locations.FilePackage(f),
+		})
+	}
+	return
 }
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/googleapis/api-linter/v2/rules/internal/testutils"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 func TestFileOptionConsistency(t *testing.T) {
@@ -161,3 +162,35 @@ func TestFileOptionConsistency(t *testing.T) {
 		}
 	})
 }
+
+func TestFileOptionConsistencyAPI(t *testing.T) {
+	// The files of an API are compared even if they do not import each
+	// other.
+	files := testutils.ParseProto3Tmpls(t, map[string]string{
+		"a.proto": `
+			package google.example.v1;
+			option java_package = "com.google.example.v1";
+		`,
+		"b.proto": `
+			package google.example.v1;
+			option java_package = "com.google.example.v1";
+		`,
+		"c.proto": `
+			package google.example.v1;
+			option java_package = "com.example.v1";
+		`,
+	}, nil)
+	api := []protoreflect.FileDescriptor{files["a.proto"], files["b.proto"], files["c.proto"]}
+	problems, err := fileOptionConsistency.LintAPI(api)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := testutils.Problems{
+		{Message: `Option "java_package" should be consistent throughout the package.`, Descriptor: files["a.proto"]},
+		{Message: `Option "java_package" should be consistent throughout the package.`, Descriptor: files["b.proto"]},
+		{Message: `Option "java_package" should be consistent throughout the package.`, Descriptor: files["c.proto"]},
+	}
+	if diff := want.Diff(problems); diff != "" {
+		t.Error(diff)
+	}
+}
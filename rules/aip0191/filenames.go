@@ -25,6 +25,9 @@ import (
 
 var filename = &lint.FileRule{
 	Name: lint.NewRuleName(191, "filenames"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Proto files must use reasonable filenames.",
+	},
 	LintFile: func(f protoreflect.FileDescriptor) []lint.Problem {
 		fn := strings.ReplaceAll(filepath.Base(string(f.Path())), ".proto", "")
 		if versionRegexp.MatchString(fn) {
@@ -26,6 +26,9 @@ import (
 
 var javaMultipleFiles = &lint.FileRule{
 	Name: lint.NewRuleName(191, "java-multiple-files"),
+	RuleInfo: lint.RuleInfo{
+		Description: "All proto files must set `option java_multiple_files = true`.",
+	},
 	OnlyIf: func(f protoreflect.FileDescriptor) bool {
 		return hasPackage(f) &&
 			!strings.HasSuffix(string(f.Package()), ".master") &&
@@ -29,6 +29,10 @@ import (
 
 var javaOuterClassname = &lint.FileRule{
 	Name: lint.NewRuleName(191, "java-outer-classname"),
+	RuleInfo: lint.RuleInfo{
+		Description: "All proto files must set `option java_outer_classname`.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FileDescriptor) bool {
 		if !hasPackage(f) || strings.HasSuffix(string(f.Package()), ".master") {
 			return false
@@ -52,8 +56,39 @@ var javaOuterClassname = &lint.FileRule{
 				),
 				Descriptor: f,
 				Location:   locations.FilePackage(f),
+				Fixes:      javaOuterClassnameFixes(f, want),
 			}}
 		}
 		return nil
 	},
 }
+
+// javaOuterClassnameFixes returns a fix that sets the java_outer_classname
+// option, either by replacing the existing option or by adding one after the
+// package statement.
+func javaOuterClassnameFixes(f protoreflect.FileDescriptor, want string) []lint.Fix {
+	option := fmt.Sprintf("option java_outer_classname = %q;", want)
+	if loc := locations.FileJavaOuterClassname(f); loc != nil {
+		return []lint.Fix{{
+			Title: fmt.Sprintf("Set `java_outer_classname` to %q", want),
+			Edits: []lint.TextEdit{{Location: loc, NewText: option}},
+		}}
+	}
+
+	// Insert the option right after the package statement.
+	span := locations.FilePackage(f).GetSpan()
+	if len(span) != 3 && len(span) != 4 {
+		return nil
+	}
+	end := []int32{span[0], span[2], span[2]}
+	if len(span) == 4 {
+		end = []int32{span[2], span[3], span[3]}
+	}
+	return []lint.Fix{{
+		Title: "Add `option java_outer_classname`",
+		Edits: []lint.TextEdit{{
+			Location: &dpb.SourceCodeInfo_Location{Span: end},
+			NewText:  "\n\n" + option,
+		}},
+	}}
+}
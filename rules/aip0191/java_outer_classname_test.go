@@ -18,6 +18,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/googleapis/api-linter/v2/rules/internal/testutils"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protodesc"
@@ -54,6 +55,36 @@ func TestJavaOuterClassname(t *testing.T) {
 	}
 }
 
+func TestJavaOuterClassnameFixes(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		statements []string
+		wantSpan   []int32
+		wantText   string
+	}{
+		{"Replace", []string{"package foo.v1;", `option java_outer_classname = "OtherProto";`}, []int32{1, 0, 43}, `option java_outer_classname = "TestProto";`},
+		{"Insert", []string{"package foo.v1;", ""}, []int32{0, 15, 15}, "\n\n" + `option java_outer_classname = "TestProto";`},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			files := testutils.ParseProtoStrings(t, map[string]string{"test.proto": strings.Join(test.statements, "\n")})
+			problems := javaOuterClassname.Lint(files["test.proto"])
+			if len(problems) != 1 || len(problems[0].Fixes) != 1 {
+				t.Fatalf("Expected one problem with one fix, got %v", problems)
+			}
+			edits := problems[0].Fixes[0].Edits
+			if len(edits) != 1 {
+				t.Fatalf("Expected one edit, got %d", len(edits))
+			}
+			if diff := cmp.Diff(test.wantSpan, edits[0].Location.GetSpan()); diff != "" {
+				t.Errorf("span mismatch (-want +got):\n%s", diff)
+			}
+			if got := edits[0].NewText; got != test.wantText {
+				t.Errorf("Got new text %q, want %q", got, test.wantText)
+			}
+		})
+	}
+}
+
 func newEdition2024File(t *testing.T, opts *descriptorpb.FileOptions) protoreflect.FileDescriptor {
 	t.Helper()
 	fdp := &descriptorpb.FileDescriptorProto{
@@ -26,6 +26,44 @@ import (
 
 var javaPackage = &lint.FileRule{
 	Name: lint.NewRuleName(191, "java-package"),
+	RuleInfo: lint.RuleInfo{
+		Description: "All proto files must set `option java_package`.",
+		Fixable:     true,
+		Examples: []lint.RuleExample{
+			{
+				Incorrect: `syntax = "proto3";
+
+package google.example.v1;
+
+// Needs ` + "`option java_package = \"com.google.example.v1\";`" + `.
+option java_multiple_files = true;
+option java_outer_classname = "LibraryProto";`,
+				Correct: `syntax = "proto3";
+
+package google.example.v1;
+
+option java_package = "com.google.example.v1";
+option java_multiple_files = true;
+option java_outer_classname = "LibraryProto";`,
+			},
+			{
+				Incorrect: `syntax = "proto3";
+
+package google.example.v1;
+
+option java_package = "google.example";  // Should end with "google.example.v1".
+option java_multiple_files = true;
+option java_outer_classname = "LibraryProto";`,
+				Correct: `syntax = "proto3";
+
+package google.example.v1;
+
+option java_package = "com.google.example.v1";
+option java_multiple_files = true;
+option java_outer_classname = "LibraryProto";`,
+			},
+		},
+	},
 	OnlyIf: func(f protoreflect.FileDescriptor) bool {
 		return hasPackage(f) && !strings.HasSuffix(string(f.Package()), ".master")
 	},
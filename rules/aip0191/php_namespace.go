@@ -28,6 +28,10 @@ import (
 
 var phpNamespace = &lint.FileRule{
 	Name: lint.NewRuleName(191, "php-namespace"),
+	RuleInfo: lint.RuleInfo{
+		Description: "The `option php_namespace` annotation should be idiomatic if set.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FileDescriptor) bool {
 		return f.Options().(*dpb.FileOptions).GetPhpNamespace() != ""
 	},
@@ -26,6 +26,9 @@ import (
 // Protobuf package must match the directory structure.
 var protoPkg = &lint.FileRule{
 	Name: lint.NewRuleName(191, "proto-package"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Proto package must match the directory structure.",
+	},
 	LintFile: func(f protoreflect.FileDescriptor) []lint.Problem {
 		dir := filepath.Dir(string(f.Path()))
 		pkg := strings.ReplaceAll(string(f.Package()), ".", string(filepath.Separator))
@@ -23,6 +23,10 @@ import (
 // APIs must use proto3.
 var syntax = &lint.FileRule{
 	Name: lint.NewRuleName(191, "proto-version"),
+	RuleInfo: lint.RuleInfo{
+		Description: "All proto files must use proto3.",
+		Fixable:     true,
+	},
 	LintFile: func(f protoreflect.FileDescriptor) []lint.Problem {
 		if f.Syntax() != protoreflect.Proto3 {
 			return []lint.Problem{{
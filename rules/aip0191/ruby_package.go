@@ -28,6 +28,10 @@ import (
 
 var rubyPackage = &lint.FileRule{
 	Name: lint.NewRuleName(191, "ruby-package"),
+	RuleInfo: lint.RuleInfo{
+		Description: "The `option ruby_package` annotation should be idiomatic if set.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FileDescriptor) bool {
 		return f.Options().(*dpb.FileOptions).GetRubyPackage() != ""
 	},
@@ -27,6 +27,9 @@ import (
 // absoluteLinks ensures that a descriptor has only absolute links.
 var absoluteLinks = &lint.DescriptorRule{
 	Name: lint.NewRuleName(192, "absolute-links"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Comments must use absolute links",
+	},
 	LintDescriptor: func(d protoreflect.Descriptor) []lint.Problem {
 		comment := strings.Join(
 			utils.SeparateInternalComments(d.ParentFile().SourceLocations().ByDescriptor(d).LeadingComments).External,
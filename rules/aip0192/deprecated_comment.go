@@ -23,7 +23,10 @@ import (
 )
 
 var deprecatedComment = &lint.DescriptorRule{
-	Name:   lint.NewRuleName(192, "deprecated-comment"),
+	Name: lint.NewRuleName(192, "deprecated-comment"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Deprecated elements must have a corresponding comment.",
+	},
 	OnlyIf: isDeprecated,
 	LintDescriptor: func(d protoreflect.Descriptor) []lint.Problem {
 		comment := utils.SeparateInternalComments(d.ParentFile().SourceLocations().ByDescriptor(d).LeadingComments)
@@ -26,6 +26,9 @@ import (
 // hasComments complains if there is no comment above something.
 var hasComments = &lint.DescriptorRule{
 	Name: lint.NewRuleName(192, "has-comments"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Everything in a proto file must be commented.",
+	},
 	LintDescriptor: func(d protoreflect.Descriptor) (problems []lint.Problem) {
 		comment := utils.SeparateInternalComments(d.ParentFile().SourceLocations().ByDescriptor(d).LeadingComments)
 		if len(comment.External) == 0 {
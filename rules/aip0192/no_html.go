@@ -24,6 +24,9 @@ import (
 
 var noHTML = &lint.DescriptorRule{
 	Name: lint.NewRuleName(192, "no-html"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Comments must not use raw HTML",
+	},
 	OnlyIf: func(d protoreflect.Descriptor) bool {
 		return len(d.ParentFile().SourceLocations().ByDescriptor(d).LeadingComments) > 0
 	},
@@ -25,6 +25,9 @@ import (
 
 var noMarkdownHeadings = &lint.DescriptorRule{
 	Name: lint.NewRuleName(192, "no-markdown-headings"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Public comments should not include Markdown headings.",
+	},
 	LintDescriptor: func(d protoreflect.Descriptor) []lint.Problem {
 		for _, cmt := range utils.SeparateInternalComments(d.ParentFile().SourceLocations().ByDescriptor(d).LeadingComments).External {
 			for _, line := range strings.Split(cmt, "\n") {
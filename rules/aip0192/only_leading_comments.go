@@ -26,6 +26,9 @@ import (
 // comments. (Internal trailing or detached comments are permitted.)
 var onlyLeadingComments = &lint.DescriptorRule{
 	Name: lint.NewRuleName(192, "only-leading-comments"),
+	RuleInfo: lint.RuleInfo{
+		Description: "All public comments should be leading comments.",
+	},
 	LintDescriptor: func(d protoreflect.Descriptor) []lint.Problem {
 		problems := []lint.Problem{}
 		if len(utils.SeparateInternalComments(d.ParentFile().SourceLocations().ByDescriptor(d).TrailingComments).External) > 0 {
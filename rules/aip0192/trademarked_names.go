@@ -17,6 +17,7 @@ package aip0192
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/googleapis/api-linter/v2/lint"
@@ -44,6 +45,13 @@ var trademarkAliases = map[string][]string{
 	"Stack Overflow": {"StackOverflow"},
 }
 
+// trademarkedNamesOptions are the options of the trademarked-names rule.
+type trademarkedNamesOptions struct {
+	// Trademarks are the misspellings to report for each trademarked name,
+	// in addition to the default ones.
+	Trademarks map[string][]string `json:"trademarks"`
+}
+
 // We actually want regexes so we do not accidentally false-positive acronyms
 // that *contain* our matches. (For example, "BQD" should not match and tell us
 // to change to BigQuery.)
@@ -59,25 +67,53 @@ func defaultTrademarkTypos() map[string][]*regexp.Regexp {
 	return tmRegexes
 }
 
-var tmRegexes = defaultTrademarkTypos()
+// optionTrademarkTypos builds the regexes of the misspellings configured in
+// the rule options. Those are plain text, so regex metacharacters (such as
+// the "." in "Example.com") match only themselves.
+func optionTrademarkTypos(aliases map[string][]string) map[string][]*regexp.Regexp {
+	tmRegexes := map[string][]*regexp.Regexp{}
+	for k, tms := range aliases {
+		for _, tm := range tms {
+			tmRegexes[k] = append(tmRegexes[k], regexp.MustCompile(`\b`+strings.ReplaceAll(regexp.QuoteMeta(tm), " ", `\s+`)+`\b`))
+		}
+	}
+	return tmRegexes
+}
 
-var trademarkedNames = &lint.DescriptorRule{
-	Name: lint.NewRuleName(192, "trademarked-names"),
-	LintDescriptor: func(d protoreflect.Descriptor) (problems []lint.Problem) {
-		c := strings.Join(
-			utils.SeparateInternalComments(d.ParentFile().SourceLocations().ByDescriptor(d).LeadingComments).External,
-			"\n",
-		)
-		for want, badThings := range tmRegexes {
-			for _, bad := range badThings {
-				if bad.MatchString(c) {
-					problems = append(problems, lint.Problem{
-						Message:    fmt.Sprintf("Use %q in comments, not %q.", want, bad),
-						Descriptor: d,
-					})
+var trademarkedNames = &lint.OptionsRule[trademarkedNamesOptions]{
+	New: func(opts trademarkedNamesOptions) lint.ProtoRule {
+		tmRegexes := defaultTrademarkTypos()
+		for want, typos := range optionTrademarkTypos(opts.Trademarks) {
+			tmRegexes[want] = append(tmRegexes[want], typos...)
+		}
+		// Report the problems in the same order on every run.
+		wants := make([]string, 0, len(tmRegexes))
+		for want := range tmRegexes {
+			wants = append(wants, want)
+		}
+		sort.Strings(wants)
+		return &lint.DescriptorRule{
+			Name: lint.NewRuleName(192, "trademarked-names"),
+			RuleInfo: lint.RuleInfo{
+				Description: "Trademarked names should be used correctly.",
+			},
+			LintDescriptor: func(d protoreflect.Descriptor) (problems []lint.Problem) {
+				c := strings.Join(
+					utils.SeparateInternalComments(d.ParentFile().SourceLocations().ByDescriptor(d).LeadingComments).External,
+					"\n",
+				)
+				for _, want := range wants {
+					for _, bad := range tmRegexes[want] {
+						if bad.MatchString(c) {
+							problems = append(problems, lint.Problem{
+								Message:    fmt.Sprintf("Use %q in comments, not %q.", want, bad),
+								Descriptor: d,
+							})
+						}
+					}
 				}
-			}
+				return
+			},
 		}
-		return
 	},
 }
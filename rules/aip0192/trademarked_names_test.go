@@ -17,6 +17,7 @@ package aip0192
 import (
 	"testing"
 
+	"github.com/googleapis/api-linter/v2/lint"
 	"github.com/googleapis/api-linter/v2/rules/internal/testutils"
 )
 
@@ -46,3 +47,35 @@ func TestTrademarkedNames(t *testing.T) {
 		}
 	}
 }
+
+func TestTrademarkedNamesOptions(t *testing.T) {
+	rule, err := trademarkedNames.WithOptions(lint.RuleOptions{
+		"trademarks": map[string]interface{}{
+			"GitHub":   []interface{}{"Git-Hub"},
+			"Acme.com": []interface{}{"Acme.org", "ACME"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		Token    string
+		problems testutils.Problems
+	}{
+		{"Acme.com", testutils.Problems{}},
+		{"Acme org", testutils.Problems{}},
+		{"Acme.org", testutils.Problems{{Message: "Acme.com"}}},
+		{"ACME", testutils.Problems{{Message: "Acme.com"}}},
+		{"Git-Hub", testutils.Problems{{Message: "GitHub"}}},
+		{"Git Hub", testutils.Problems{{Message: "GitHub"}}},
+	} {
+		f := testutils.ParseProto3Tmpl(t, `
+			// This is a comment that says {{.Token}}.
+			message Foo {}
+		`, test)
+		m := f.Messages().Get(0)
+		if diff := test.problems.SetDescriptor(m).Diff(rule.Lint(f)); diff != "" {
+			t.Errorf("%s: %s", test.Token, diff)
+		}
+	}
+}
@@ -33,6 +33,9 @@ var stringOnlyFormats = stringset.New(
 
 var stringOnlyFormat = &lint.FieldRule{
 	Name: lint.NewRuleName(202, "string-only-format"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Certain field formats can only be applied to a string field.",
+	},
 	OnlyIf: func(fd protoreflect.FieldDescriptor) bool {
 		return utils.HasFormat(fd) && fd.Kind() != protoreflect.StringKind
 	},
@@ -34,6 +34,9 @@ var excusedResourceFields = stringset.New(
 
 var fieldBehaviorRequired = &lint.MethodRule{
 	Name: lint.NewRuleName(203, "field-behavior-required"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Field behavior is required, and must have one of OUTPUT_ONLY, REQUIRED, or OPTIONAL.",
+	},
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		req := m.Input()
 		p := string(m.ParentFile().Package())
@@ -22,6 +22,9 @@ import (
 
 var resourceIdentifierOnly = &lint.FieldRule{
 	Name: lint.NewRuleName(203, "resource-identifier-only"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Only the resource's name field can have field behavior IDENTIFIER.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		return utils.GetFieldBehavior(f).Contains("IDENTIFIER")
 	},
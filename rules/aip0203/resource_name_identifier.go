@@ -24,6 +24,9 @@ import (
 
 var resourceNameIdentifier = &lint.MessageRule{
 	Name: lint.NewRuleName(203, "resource-name-identifier"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resource name field must have field behavior IDENTIFIER.",
+	},
 	OnlyIf: func(m protoreflect.MessageDescriptor) bool {
 		return utils.IsResource(m) && m.Fields().ByName(protoreflect.Name(utils.GetResourceNameField(utils.GetResource(m)))) != nil
 	},
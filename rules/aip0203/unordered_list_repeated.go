@@ -22,6 +22,9 @@ import (
 
 var unorderedListRepeated = &lint.FieldRule{
 	Name: lint.NewRuleName(203, "unordered-list-repeated"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Only repeated fields may be annotated with `UNORDERED_LIST`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		return utils.GetFieldBehavior(f).Contains("UNORDERED_LIST")
 	},
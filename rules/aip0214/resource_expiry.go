@@ -22,6 +22,9 @@ import (
 
 var resourceExpiry = &lint.FieldRule{
 	Name: lint.NewRuleName(214, "resource-expiry"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Resources with user-set expiry times should offer a ttl field.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		isResource := utils.IsResource(f.Parent().(protoreflect.MessageDescriptor))
 		isExpireTime := f.Name() == "expire_time"
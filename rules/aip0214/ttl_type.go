@@ -25,6 +25,10 @@ import (
 
 var ttlType = &lint.FieldRule{
 	Name: lint.NewRuleName(214, "ttl-type"),
+	RuleInfo: lint.RuleInfo{
+		Description: "The `ttl` field should be a `google.protobuf.Duration`.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		return f.Name() == "ttl"
 	},
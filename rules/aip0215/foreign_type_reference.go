@@ -26,6 +26,9 @@ import (
 
 var foreignTypeReference = &lint.FieldRule{
 	Name: lint.NewRuleName(215, "foreign-type-reference"),
+	RuleInfo: lint.RuleInfo{
+		Description: "API should not reference foreign types outside of the API scope.",
+	},
 	OnlyIf: func(fd protoreflect.FieldDescriptor) bool {
 		return fd.Kind() == protoreflect.MessageKind
 	},
@@ -26,6 +26,9 @@ import (
 
 var versionedPackages = &lint.FileRule{
 	Name: lint.NewRuleName(215, "versioned-packages"),
+	RuleInfo: lint.RuleInfo{
+		Description: "API-specific protos should be in versioned packages.",
+	},
 	OnlyIf: func(f protoreflect.FileDescriptor) bool {
 		// Common protos are exempt.
 		if utils.IsCommonProto(f) {
@@ -24,6 +24,9 @@ import (
 
 var nesting = &lint.EnumRule{
 	Name: lint.NewRuleName(216, "nesting"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Lifecycle state enums should be nested within the resource.",
+	},
 	OnlyIf: func(e protoreflect.EnumDescriptor) bool {
 		return strings.HasSuffix(string(e.Name()), "State") && e.Name() != "State"
 	},
@@ -25,6 +25,9 @@ import (
 
 var stateFieldOutputOnly = &lint.FieldRule{
 	Name: lint.NewRuleName(216, "state-field-output-only"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Lifecycle State enum fields should be marked OUTPUT_ONLY",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		// We care about the name of the State enum type.
 		// AIP 0216 makes no mention of the state field name.
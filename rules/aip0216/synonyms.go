@@ -24,6 +24,10 @@ import (
 
 var synonyms = &lint.EnumRule{
 	Name: lint.NewRuleName(216, "synonyms"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Lifecycle state enums should be called \"State\", not \"Status\".",
+		Fixable:     true,
+	},
 	LintEnum: func(e protoreflect.EnumDescriptor) []lint.Problem {
 		if strings.HasSuffix(string(e.Name()), "Status") {
 			return []lint.Problem{{
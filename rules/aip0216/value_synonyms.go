@@ -25,6 +25,10 @@ import (
 
 var valueSynonyms = &lint.EnumValueRule{
 	Name: lint.NewRuleName(216, "value-synonyms"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Enforce common state values.",
+		Fixable:     true,
+	},
 	OnlyIf: func(v protoreflect.EnumValueDescriptor) bool {
 		return strings.HasSuffix(string(v.Parent().(protoreflect.EnumDescriptor).Name()), "State")
 	},
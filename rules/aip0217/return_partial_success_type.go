@@ -23,6 +23,10 @@ import (
 
 var returnPartialSuccessType = &lint.FieldRule{
 	Name: lint.NewRuleName(217, "return-partial-success-type"),
+	RuleInfo: lint.RuleInfo{
+		Description: "The return_partial_success field should be a bool.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		return f.Name() == "return_partial_success"
 	},
@@ -21,6 +21,9 @@ import (
 
 var returnPartialSuccessWithUnreachable = &lint.MethodRule{
 	Name: lint.NewRuleName(217, "return-partial-success-with-unreachable"),
+	RuleInfo: lint.RuleInfo{
+		Description: "The return_partial_success field should be paired with unreachable.",
+	},
 	OnlyIf: func(m protoreflect.MethodDescriptor) bool {
 		return m.Input().Fields().ByName("return_partial_success") != nil
 	},
@@ -27,6 +27,10 @@ var synSet = stringset.New(
 
 var synonyms = &lint.FieldRule{
 	Name: lint.NewRuleName(217, "synonyms"),
+	RuleInfo: lint.RuleInfo{
+		Description: "The field for unreachable resources should be named `unreachable`.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return m.Fields().ByName("next_page_token") != nil
@@ -23,6 +23,10 @@ import (
 
 var unreachableFieldType = &lint.FieldRule{
 	Name: lint.NewRuleName(217, "unreachable-field-type"),
+	RuleInfo: lint.RuleInfo{
+		Description: "The unreachable field should be a repeated string.",
+		Fixable:     true,
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		return f.Name() == "unreachable"
 	},
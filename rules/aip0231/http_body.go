@@ -21,7 +21,10 @@ import (
 
 // Batch Get methods should not have an HTTP body.
 var httpBody = &lint.MethodRule{
-	Name:       lint.NewRuleName(231, "http-body"),
+	Name: lint.NewRuleName(231, "http-body"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Get methods must not have an HTTP body.",
+	},
 	OnlyIf:     isBatchGetMethod,
 	LintMethod: utils.LintNoHTTPBody,
 }
@@ -21,7 +21,10 @@ import (
 
 // Batch Get methods should use the HTTP GET verb.
 var httpVerb = &lint.MethodRule{
-	Name:       lint.NewRuleName(231, "http-method"),
+	Name: lint.NewRuleName(231, "http-method"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Get methods must use the GET HTTP verb.",
+	},
 	OnlyIf:     isBatchGetMethod,
 	LintMethod: utils.LintHTTPMethod("GET"),
 }
@@ -23,7 +23,10 @@ import (
 
 // Batch Get methods should have a proper HTTP pattern.
 var uriSuffix = &lint.MethodRule{
-	Name:   lint.NewRuleName(231, "http-uri-suffix"),
+	Name: lint.NewRuleName(231, "http-uri-suffix"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Get methods must have the correct URI suffix",
+	},
 	OnlyIf: isBatchGetMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		for _, httpRule := range utils.GetHTTPRules(m) {
@@ -21,7 +21,11 @@ import (
 )
 
 var pluralMethodResourceName = &lint.MethodRule{
-	Name:   lint.NewRuleName(231, "plural-method-name"),
+	Name: lint.NewRuleName(231, "plural-method-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Get methods must have plural names.",
+		Fixable:     true,
+	},
 	OnlyIf: isBatchGetMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		return utils.LintPluralMethodName(m, "BatchGet")
@@ -21,7 +21,11 @@ import (
 
 // Batch Get method should have a properly named Request message.
 var inputName = &lint.MethodRule{
-	Name:       lint.NewRuleName(231, "request-message-name"),
+	Name: lint.NewRuleName(231, "request-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Get methods must have standardized request message names.",
+		Fixable:     true,
+	},
 	OnlyIf:     isBatchGetMethod,
 	LintMethod: utils.LintMethodHasMatchingRequestName,
 }
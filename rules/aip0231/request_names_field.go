@@ -27,7 +27,11 @@ import (
 // The Batch Get standard method should have repeated name field or repeated
 // standard get request message field, but the latter one is not suggested.
 var namesField = &lint.MessageRule{
-	Name:   lint.NewRuleName(231, "request-names-field"),
+	Name: lint.NewRuleName(231, "request-names-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Get RPCs should have a `names` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf: isBatchGetRequestMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) (problems []lint.Problem) {
 		// Rule check: Establish that a name field is present.
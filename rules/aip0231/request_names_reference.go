@@ -22,6 +22,9 @@ import (
 
 var requestNamesReference = &lint.FieldRule{
 	Name: lint.NewRuleName(231, "request-names-reference"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Get requests should annotate the `names` field with `google.api.resource_reference`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return isBatchGetRequestMessage(m) && f.Name() == "names"
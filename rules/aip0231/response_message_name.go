@@ -21,7 +21,11 @@ import (
 
 // Batch Get method should have a properly named Response message.
 var outputName = &lint.MethodRule{
-	Name:       lint.NewRuleName(231, "response-message-name"),
+	Name: lint.NewRuleName(231, "response-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Get methods must have standardized response message names.",
+		Fixable:     true,
+	},
 	OnlyIf:     isBatchGetMethod,
 	LintMethod: utils.LintMethodHasMatchingResponseName,
 }
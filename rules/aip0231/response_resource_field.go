@@ -25,7 +25,10 @@ import (
 
 // The Batch Get response message should have resource field.
 var resourceField = &lint.MessageRule{
-	Name:   lint.NewRuleName(231, "response-resource-field"),
+	Name: lint.NewRuleName(231, "response-resource-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Get RPCs must have a repeated field for the resource in the response.",
+	},
 	OnlyIf: isBatchGetResponseMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		// The singular form the resource message name; the first letter capitalized.
@@ -21,7 +21,10 @@ import (
 
 // Batch Create methods should use "*" as the HTTP body.
 var httpBody = &lint.MethodRule{
-	Name:       lint.NewRuleName(233, "http-body"),
+	Name: lint.NewRuleName(233, "http-body"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Create methods should use `*` as the HTTP body.",
+	},
 	OnlyIf:     isBatchCreateMethod,
 	LintMethod: utils.LintWildcardHTTPBody,
 }
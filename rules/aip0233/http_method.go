@@ -21,7 +21,10 @@ import (
 
 // Batch Create methods should use the HTTP POST verb.
 var httpVerb = &lint.MethodRule{
-	Name:       lint.NewRuleName(233, "http-method"),
+	Name: lint.NewRuleName(233, "http-method"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Create methods must use the POST HTTP verb.",
+	},
 	OnlyIf:     isBatchCreateMethod,
 	LintMethod: utils.LintHTTPMethod("POST"),
 }
@@ -23,7 +23,10 @@ import (
 
 // Batch Create methods should have a proper HTTP pattern.
 var httpURISuffix = &lint.MethodRule{
-	Name:   lint.NewRuleName(233, "http-uri-suffix"),
+	Name: lint.NewRuleName(233, "http-uri-suffix"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Create methods must have the correct URI suffix",
+	},
 	OnlyIf: isBatchCreateMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		for _, httpRule := range utils.GetHTTPRules(m) {
@@ -21,7 +21,11 @@ import (
 
 // Batch Create method should have a properly named Request message.
 var requestMessageName = &lint.MethodRule{
-	Name:       lint.NewRuleName(233, "request-message-name"),
+	Name: lint.NewRuleName(233, "request-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Create methods must have standardized request message names.",
+		Fixable:     true,
+	},
 	OnlyIf:     isBatchCreateMethod,
 	LintMethod: utils.LintMethodHasMatchingRequestName,
 }
@@ -27,6 +27,10 @@ import (
 // The Batch Create request message should have parent field.
 var requestParentField = &lint.MessageRule{
 	Name: lint.NewRuleName(233, "request-parent-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Create RPCs must have a `parent` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf: func(m protoreflect.MessageDescriptor) bool {
 		// In order to parse out the pattern, we get the resource message
 		// from the response, then get the resource annotation from that,
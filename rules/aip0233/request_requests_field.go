@@ -27,7 +27,11 @@ import (
 // The Batch Create standard method should have repeated standard create request
 // message field.
 var requestRequestsField = &lint.MessageRule{
-	Name:   lint.NewRuleName(233, "request-requests-field"),
+	Name: lint.NewRuleName(233, "request-requests-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Create RPCs should have a `requests` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf: isBatchCreateRequestMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) (problems []lint.Problem) {
 		// Rule check: Establish that a "requests" field is present.
@@ -26,6 +26,9 @@ import (
 // Batch Create methods should not have unrecognized fields.
 var requestUnknownFields = &lint.FieldRule{
 	Name: lint.NewRuleName(233, "request-unknown-fields"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Create RPCs should not have unexpected fields in the request.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return isBatchCreateRequestMessage(m)
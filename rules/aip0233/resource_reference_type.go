@@ -25,6 +25,9 @@ import (
 // the parent directly via `type`.
 var resourceReferenceType = &lint.MethodRule{
 	Name: lint.NewRuleName(233, "resource-reference-type"),
+	RuleInfo: lint.RuleInfo{
+		Description: "BatchCreate should use a `child_type` reference to the created resource.",
+	},
 	OnlyIf: func(m protoreflect.MethodDescriptor) bool {
 		// Return type of the RPC.
 		ot := utils.GetResponseType(m)
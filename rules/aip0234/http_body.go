@@ -21,7 +21,10 @@ import (
 
 // Batch Update methods should use "*" as the HTTP body.
 var httpBody = &lint.MethodRule{
-	Name:       lint.NewRuleName(234, "http-body"),
+	Name: lint.NewRuleName(234, "http-body"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Update methods should use `*` as the HTTP body.",
+	},
 	OnlyIf:     isBatchUpdateMethod,
 	LintMethod: utils.LintWildcardHTTPBody,
 }
@@ -21,7 +21,10 @@ import (
 
 // Batch Create methods should use the HTTP POST verb.
 var httpMethod = &lint.MethodRule{
-	Name:       lint.NewRuleName(234, "http-method"),
+	Name: lint.NewRuleName(234, "http-method"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Update methods must use the POST HTTP verb.",
+	},
 	OnlyIf:     isBatchUpdateMethod,
 	LintMethod: utils.LintHTTPMethod("POST"),
 }
@@ -23,7 +23,10 @@ import (
 
 // Batch Update methods should have a proper HTTP pattern.
 var httpURISuffix = &lint.MethodRule{
-	Name:   lint.NewRuleName(234, "http-uri-suffix"),
+	Name: lint.NewRuleName(234, "http-uri-suffix"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Update methods must have the correct URI suffix",
+	},
 	OnlyIf: isBatchUpdateMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		for _, httpRule := range utils.GetHTTPRules(m) {
@@ -21,7 +21,11 @@ import (
 )
 
 var pluralMethodName = &lint.MethodRule{
-	Name:   lint.NewRuleName(234, "plural-method-name"),
+	Name: lint.NewRuleName(234, "plural-method-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Update methods must have plural names.",
+		Fixable:     true,
+	},
 	OnlyIf: isBatchUpdateMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		return utils.LintPluralMethodName(m, "BatchUpdate")
@@ -21,7 +21,11 @@ import (
 
 // Batch Update method should have a properly named Request message.
 var requestMessageName = &lint.MethodRule{
-	Name:       lint.NewRuleName(234, "request-message-name"),
+	Name: lint.NewRuleName(234, "request-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Update methods must have standardized request message names.",
+		Fixable:     true,
+	},
 	OnlyIf:     isBatchUpdateMethod,
 	LintMethod: utils.LintMethodHasMatchingRequestName,
 }
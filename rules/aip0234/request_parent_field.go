@@ -27,6 +27,10 @@ import (
 // The Batch Update request message should have parent field.
 var requestParentField = &lint.MessageRule{
 	Name: lint.NewRuleName(234, "request-parent-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Update RPCs must have a `parent` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf: func(m protoreflect.MessageDescriptor) bool {
 		// In order to parse out the pattern, we get the resource message
 		// from the response, then get the resource annotation from that,
@@ -22,6 +22,9 @@ import (
 
 var requestParentReference = &lint.FieldRule{
 	Name: lint.NewRuleName(234, "request-parent-reference"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Update requests should annotate the `parent` field with `google.api.resource_reference`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return isBatchUpdateRequestMessage(m) && f.Name() == "parent"
@@ -22,6 +22,9 @@ import (
 
 var requestRequestsBehavior = &lint.FieldRule{
 	Name: lint.NewRuleName(234, "request-requests-behavior"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Update requests should annotate the `requests` field with `google.api.field_behavior`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return isBatchUpdateRequestMessage(m) && f.Name() == "requests"
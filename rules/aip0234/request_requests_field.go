@@ -27,7 +27,11 @@ import (
 // The Batch Update standard method should have repeated standard Update request
 // message field.
 var requestRequestsField = &lint.MessageRule{
-	Name:   lint.NewRuleName(234, "request-requests-field"),
+	Name: lint.NewRuleName(234, "request-requests-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Update RPCs should have a `requests` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf: isBatchUpdateRequestMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) (problems []lint.Problem) {
 		// Rule check: Establish that a "requests" field is present.
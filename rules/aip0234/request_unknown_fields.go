@@ -26,6 +26,9 @@ import (
 // Batch Update methods should not have unrecognized fields.
 var requestUnknownFields = &lint.FieldRule{
 	Name: lint.NewRuleName(234, "request-unknown-fields"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Update RPCs should not have unexpected fields in the request.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return isBatchUpdateRequestMessage(m)
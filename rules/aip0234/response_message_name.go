@@ -25,7 +25,11 @@ import (
 
 // Batch Update method should have a properly named Response message.
 var responseMessageName = &lint.MethodRule{
-	Name:   lint.NewRuleName(234, "response-message-name"),
+	Name: lint.NewRuleName(234, "response-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Update methods must have standardized response message names.",
+		Fixable:     true,
+	},
 	OnlyIf: isBatchUpdateMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		// Proper response name should be the concatenation of the method name and
@@ -25,7 +25,10 @@ import (
 
 // The Batch Update response message should have resource field.
 var responseResourceField = &lint.MessageRule{
-	Name:   lint.NewRuleName(234, "response-resource-field"),
+	Name: lint.NewRuleName(234, "response-resource-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Update RPCs must have a repeated field for the resource in the response.",
+	},
 	OnlyIf: isBatchUpdateResponseMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		// the singular form the resource name, the first letter is Capitalized.
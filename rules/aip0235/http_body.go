@@ -21,7 +21,10 @@ import (
 
 // Batch Delete methods should use "*" as the HTTP body.
 var httpBody = &lint.MethodRule{
-	Name:       lint.NewRuleName(235, "http-body"),
+	Name: lint.NewRuleName(235, "http-body"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Delete methods should use `*` as the HTTP body.",
+	},
 	OnlyIf:     isBatchDeleteMethod,
 	LintMethod: utils.LintWildcardHTTPBody,
 }
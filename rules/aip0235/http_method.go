@@ -21,7 +21,10 @@ import (
 
 // Batch Delete methods should use the HTTP POST method.
 var httpMethod = &lint.MethodRule{
-	Name:       lint.NewRuleName(235, "http-method"),
+	Name: lint.NewRuleName(235, "http-method"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Delete methods must use the POST HTTP verb.",
+	},
 	OnlyIf:     isBatchDeleteMethod,
 	LintMethod: utils.LintHTTPMethod("POST"),
 }
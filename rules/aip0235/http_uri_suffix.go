@@ -23,7 +23,10 @@ import (
 
 // Batch Delete methods should have a proper HTTP pattern.
 var httpURISuffix = &lint.MethodRule{
-	Name:   lint.NewRuleName(235, "http-uri-suffix"),
+	Name: lint.NewRuleName(235, "http-uri-suffix"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Delete methods must have the correct URI suffix",
+	},
 	OnlyIf: isBatchDeleteMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		for _, httpRule := range utils.GetHTTPRules(m) {
@@ -21,7 +21,11 @@ import (
 
 // Batch Delete messages should have a properly named Request message.
 var requestMessageName = &lint.MethodRule{
-	Name:       lint.NewRuleName(235, "request-message-name"),
+	Name: lint.NewRuleName(235, "request-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Delete methods must have standardized request message names.",
+		Fixable:     true,
+	},
 	OnlyIf:     isBatchDeleteMethod,
 	LintMethod: utils.LintMethodHasMatchingRequestName,
 }
@@ -27,7 +27,11 @@ import (
 // The Batch Delete standard method should have repeated name field or repeated
 // standard delete request message field, but the latter one is not suggested.
 var requestNamesField = &lint.MessageRule{
-	Name:   lint.NewRuleName(235, "request-names-field"),
+	Name: lint.NewRuleName(235, "request-names-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Delete RPCs should have a `names` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf: isBatchDeleteRequestMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) (problems []lint.Problem) {
 		// Rule check: Establish that a name field is present.
@@ -22,6 +22,9 @@ import (
 
 var requestNamesReference = &lint.FieldRule{
 	Name: lint.NewRuleName(235, "request-names-reference"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Delete requests should annotate the `names` field with `google.api.resource_reference`.",
+	},
 	OnlyIf: func(f protoreflect.FieldDescriptor) bool {
 		if m, ok := f.Parent().(protoreflect.MessageDescriptor); ok {
 			return isBatchDeleteRequestMessage(m) && f.Name() == "names"
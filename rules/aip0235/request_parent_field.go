@@ -25,6 +25,10 @@ import (
 // The Batch Delete request message should have parent field.
 var requestParentField = &lint.MessageRule{
 	Name: lint.NewRuleName(235, "request-parent-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Delete RPCs must have a `parent` field in the request.",
+		Fixable:     true,
+	},
 	OnlyIf: func(m protoreflect.MessageDescriptor) bool {
 		// In order to parse out the pattern, we get the resource message
 		// from the request name, then get the resource annotation from that,
@@ -26,7 +26,11 @@ import (
 
 // Batch Delete method should have a properly named response message.
 var responseMessageName = &lint.MethodRule{
-	Name:   lint.NewRuleName(235, "response-message-name"),
+	Name: lint.NewRuleName(235, "response-message-name"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Delete methods must have standardized response message names.",
+		Fixable:     true,
+	},
 	OnlyIf: isBatchDeleteMethod,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		got := string(m.Output().FullName())
@@ -25,7 +25,10 @@ import (
 
 // The Batch Delete response message should have resource field.
 var responseResourceField = &lint.MessageRule{
-	Name:   lint.NewRuleName(235, "response-resource-field"),
+	Name: lint.NewRuleName(235, "response-resource-field"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Batch Delete RPCs must have a repeated field for the resource in the response.",
+	},
 	OnlyIf: isBatchDeleteResponseMessage,
 	LintMessage: func(m protoreflect.MessageDescriptor) []lint.Problem {
 		// the singular form the resource name, the first letter is Capitalized.
@@ -25,7 +25,10 @@ import (
 
 // Only the last field in a method signature can be repeated.
 var repeatedFields = &lint.MethodRule{
-	Name:   lint.NewRuleName(4232, "repeated-fields"),
+	Name: lint.NewRuleName(4232, "repeated-fields"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Method Signatures can only have repeated fields in the last element.",
+	},
 	OnlyIf: hasMethodSignatures,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		var problems []lint.Problem
@@ -27,7 +27,10 @@ import (
 
 // All fields annotated as REQUIRED must be in every method_signature.
 var requiredFields = &lint.MethodRule{
-	Name:   lint.NewRuleName(4232, "required-fields"),
+	Name: lint.NewRuleName(4232, "required-fields"),
+	RuleInfo: lint.RuleInfo{
+		Description: "Method Signatures must contain all fields annotated as required.",
+	},
 	OnlyIf: hasMethodSignatures,
 	LintMethod: func(m protoreflect.MethodDescriptor) []lint.Problem {
 		var problems []lint.Problem
@@ -32,6 +32,7 @@
 //
 //	var myRule = &lint.MessageRule{
 //	  Name: lint.NewRuleName(1234, "my-rule"),
+//	  Description: "Messages should not be bad.",
 //	  LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
 //	    if isBad(m) {
 //	      return []lint.Problem{{
@@ -49,3 +49,15 @@ func TestAdd(t *testing.T) {
 		t.Errorf("Add got an error: %v", err)
 	}
 }
+
+func TestRulesHaveDescriptions(t *testing.T) {
+	registry := lint.NewRuleRegistry()
+	if err := Add(registry); err != nil {
+		t.Fatal(err)
+	}
+	for name, rule := range registry {
+		if lint.GetRuleMetadata(rule).Description == "" {
+			t.Errorf("Rule %q has no description.", name)
+		}
+	}
+}